@@ -0,0 +1,103 @@
+package goselenium
+
+// Capabilities describes the desired capabilities of a session, such as the
+// browser to use. Build one with a browser constructor (e.g. Firefox(),
+// Chrome()) and chain the With* methods to customise it further.
+type Capabilities struct {
+	browser  Browser
+	version  string
+	platform string
+	extra    map[string]interface{}
+}
+
+// Browser is the capability that identifies which browser a session should
+// be created against.
+type Browser interface {
+	// BrowserName is the browserName capability value understood by both
+	// the legacy JSONWire protocol and W3C.
+	BrowserName() string
+}
+
+type browser struct {
+	name string
+}
+
+func (b *browser) BrowserName() string {
+	return b.name
+}
+
+// Firefox returns Capabilities requesting a Firefox session.
+func Firefox() Capabilities {
+	return Capabilities{browser: &browser{name: "firefox"}}
+}
+
+// Chrome returns Capabilities requesting a Chrome session.
+func Chrome() Capabilities {
+	return Capabilities{browser: &browser{name: "chrome"}}
+}
+
+// Browser returns the browser capability that has been configured.
+func (c Capabilities) Browser() Browser {
+	return c.browser
+}
+
+// WithVersion sets the desired browser version capability.
+func (c Capabilities) WithVersion(version string) Capabilities {
+	c.version = version
+	return c
+}
+
+// WithPlatform sets the desired platform capability.
+func (c Capabilities) WithPlatform(platform string) Capabilities {
+	c.platform = platform
+	return c
+}
+
+// WithCapability sets an arbitrary vendor or W3C capability that this
+// package does not model explicitly (e.g. "goog:chromeOptions").
+func (c Capabilities) WithCapability(name string, value interface{}) Capabilities {
+	extra := make(map[string]interface{}, len(c.extra)+1)
+	for k, v := range c.extra {
+		extra[k] = v
+	}
+	extra[name] = value
+	c.extra = extra
+	return c
+}
+
+// jsonWireCapabilities renders the capabilities as a legacy
+// "desiredCapabilities" body.
+func (c Capabilities) jsonWireCapabilities() map[string]interface{} {
+	m := make(map[string]interface{}, len(c.extra)+3)
+	for k, v := range c.extra {
+		m[k] = v
+	}
+	if c.browser != nil {
+		m["browserName"] = c.browser.BrowserName()
+	}
+	if c.version != "" {
+		m["version"] = c.version
+	}
+	if c.platform != "" {
+		m["platform"] = c.platform
+	}
+	return m
+}
+
+// w3cCapabilities renders the capabilities as a W3C "alwaysMatch" body.
+func (c Capabilities) w3cCapabilities() map[string]interface{} {
+	m := make(map[string]interface{}, len(c.extra)+3)
+	for k, v := range c.extra {
+		m[k] = v
+	}
+	if c.browser != nil {
+		m["browserName"] = c.browser.BrowserName()
+	}
+	if c.version != "" {
+		m["browserVersion"] = c.version
+	}
+	if c.platform != "" {
+		m["platformName"] = c.platform
+	}
+	return m
+}
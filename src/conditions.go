@@ -0,0 +1,214 @@
+package goselenium
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TitleIs returns a Condition that is satisfied once the page title equals
+// title exactly.
+func TitleIs(title string) Condition {
+	return &titleCondition{want: title, match: func(current string) bool {
+		return current == title
+	}}
+}
+
+// TitleContains returns a Condition that is satisfied once the page title
+// contains substr.
+func TitleContains(substr string) Condition {
+	return &titleCondition{want: substr, match: func(current string) bool {
+		return strings.Contains(current, substr)
+	}}
+}
+
+type titleCondition struct {
+	want  string
+	match func(current string) bool
+}
+
+func (c *titleCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	resp, err := driver.Title(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.match(resp.Title), nil
+}
+
+// URLMatches returns a Condition that is satisfied once the current URL
+// contains substr.
+func URLMatches(substr string) Condition {
+	return &urlCondition{substr: substr}
+}
+
+type urlCondition struct {
+	substr string
+}
+
+func (c *urlCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	resp, err := driver.CurrentURL(ctx)
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(resp.URL, c.substr), nil
+}
+
+// ElementPresent returns a Condition that is satisfied once an element
+// located by "by" can be found.
+func ElementPresent(by By) Condition {
+	return &elementPresentCondition{by: by}
+}
+
+type elementPresentCondition struct {
+	by By
+}
+
+func (c *elementPresentCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	_, err := driver.FindElement(ctx, c.by)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ElementVisible returns a Condition that is satisfied once an element
+// located by "by" is present and displayed.
+func ElementVisible(by By) Condition {
+	return &elementVisibleCondition{by: by}
+}
+
+type elementVisibleCondition struct {
+	by By
+}
+
+func (c *elementVisibleCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	element, err := driver.FindElement(ctx, c.by)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := element.Displayed(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Displayed, nil
+}
+
+// ElementClickable returns a Condition that is satisfied once an element
+// located by "by" is present, displayed and enabled.
+func ElementClickable(by By) Condition {
+	return &elementClickableCondition{by: by}
+}
+
+type elementClickableCondition struct {
+	by By
+}
+
+func (c *elementClickableCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	element, err := driver.FindElement(ctx, c.by)
+	if err != nil {
+		return false, err
+	}
+
+	displayed, err := element.Displayed(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !displayed.Displayed {
+		return false, nil
+	}
+
+	enabled, err := element.Enabled(ctx)
+	if err != nil {
+		return false, err
+	}
+	return enabled.Enabled, nil
+}
+
+// alertChecker is implemented by drivers that can report whether an alert is
+// currently open. It lets AlertPresent work against any WebDriver without
+// forcing the full alert-handling surface into this file.
+type alertChecker interface {
+	hasAlert(ctx context.Context) (bool, error)
+}
+
+// AlertPresent returns a Condition that is satisfied once a JavaScript alert
+// is open.
+func AlertPresent() Condition {
+	return &alertPresentCondition{}
+}
+
+type alertPresentCondition struct{}
+
+func (c *alertPresentCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	checker, ok := driver.(alertChecker)
+	if !ok {
+		return false, newInvalidArgumentError("AlertPresent() used against a driver with no alert support", "driver", fmt.Sprintf("%T", driver))
+	}
+	return checker.hasAlert(ctx)
+}
+
+// Not returns a Condition that is satisfied when cond is not, and vice
+// versa. Errors returned by cond are passed through unchanged.
+func Not(cond Condition) Condition {
+	return &notCondition{cond: cond}
+}
+
+type notCondition struct {
+	cond Condition
+}
+
+func (c *notCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	ok, err := c.cond.Evaluate(ctx, driver)
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
+// Any returns a Condition that is satisfied as soon as one of conds is
+// satisfied. Conditions are evaluated in order; an error from any of them
+// before a success is found is returned immediately.
+func Any(conds ...Condition) Condition {
+	return &anyCondition{conds: conds}
+}
+
+type anyCondition struct {
+	conds []Condition
+}
+
+func (c *anyCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	for _, cond := range c.conds {
+		ok, err := cond.Evaluate(ctx, driver)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// All returns a Condition that is satisfied only once every one of conds is
+// satisfied.
+func All(conds ...Condition) Condition {
+	return &allCondition{conds: conds}
+}
+
+type allCondition struct {
+	conds []Condition
+}
+
+func (c *allCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	for _, cond := range c.conds {
+		ok, err := cond.Evaluate(ctx, driver)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,193 @@
+package goselenium
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors for well-known W3C error codes. CommunicationError unwraps
+// to the matching sentinel when the remote end's response body names one of
+// these codes, so callers can compare against them with errors.Is instead of
+// matching on message text - including via WithIgnoredErrors, e.g.
+// Wait(ctx, cond, WithIgnoredErrors(goselenium.ErrNoSuchElement)).
+var (
+	ErrNoSuchElement         = errors.New("goselenium: no such element")
+	ErrNoSuchAlert           = errors.New("goselenium: no such alert")
+	ErrStaleElementReference = errors.New("goselenium: stale element reference")
+)
+
+// w3cErrorCodes maps the W3C "error" field to the sentinel it corresponds
+// to. See https://www.w3.org/TR/webdriver/#errors.
+var w3cErrorCodes = map[string]error{
+	"no such element":         ErrNoSuchElement,
+	"no such alert":           ErrNoSuchAlert,
+	"stale element reference": ErrStaleElementReference,
+}
+
+// w3cErrorBody is the shape of a W3C error response body.
+type w3cErrorBody struct {
+	Value struct {
+		Error string `json:"error"`
+	} `json:"value"`
+}
+
+// CommunicationError is returned when a request to the remote end could not
+// be completed, either because it never got a response or because the
+// response carried a non-2xx status. Response holds the raw response body,
+// if one was received, so callers that need more than Error()'s message can
+// still inspect what the remote end said.
+type CommunicationError struct {
+	Err           error
+	CallingMethod string
+	URL           string
+	Response      []byte
+}
+
+func newCommunicationError(err error, callingMethod, url string, response []byte) *CommunicationError {
+	return &CommunicationError{Err: err, CallingMethod: callingMethod, URL: url, Response: response}
+}
+
+func (e *CommunicationError) Error() string {
+	return fmt.Sprintf("goselenium: %s: request to %s failed: %v", e.CallingMethod, e.URL, e.Err)
+}
+
+// Unwrap returns the sentinel error matching the W3C error code in Response,
+// if any, so that errors.Is(err, ErrNoSuchAlert) and similar work against a
+// CommunicationError without the caller having to parse the body itself.
+// Otherwise it returns the underlying transport error.
+func (e *CommunicationError) Unwrap() error {
+	var body w3cErrorBody
+	if json.Unmarshal(e.Response, &body) == nil {
+		if sentinel, ok := w3cErrorCodes[body.Value.Error]; ok {
+			return sentinel
+		}
+	}
+	return e.Err
+}
+
+// SessionIDError is returned when a command that requires an active session
+// is called before one has been created with CreateSession.
+type SessionIDError struct {
+	CallingMethod string
+}
+
+func newSessionIDError(callingMethod string) *SessionIDError {
+	return &SessionIDError{CallingMethod: callingMethod}
+}
+
+func (e *SessionIDError) Error() string {
+	return fmt.Sprintf("goselenium: %s called without an active session; call CreateSession() first", e.CallingMethod)
+}
+
+// InvalidArgumentError is returned when a caller-supplied argument fails
+// local validation before any request is sent to the remote end.
+type InvalidArgumentError struct {
+	Message  string
+	Argument string
+	Value    string
+}
+
+func newInvalidArgumentError(message, argument, value string) *InvalidArgumentError {
+	return &InvalidArgumentError{Message: message, Argument: argument, Value: value}
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return fmt.Sprintf("goselenium: %s (%s=%q)", e.Message, e.Argument, e.Value)
+}
+
+// InvalidURLError is returned when Go() is called with a URL the local
+// client can reject outright, without needing a round trip to the remote
+// end.
+type InvalidURLError struct {
+	Err error
+	URL string
+}
+
+func newInvalidURLError(err error, url string) *InvalidURLError {
+	return &InvalidURLError{Err: err, URL: url}
+}
+
+func (e *InvalidURLError) Error() string {
+	return fmt.Sprintf("goselenium: invalid URL %q: %v", e.URL, e.Err)
+}
+
+func (e *InvalidURLError) Unwrap() error {
+	return e.Err
+}
+
+// MarshallingError is returned when a request body fails to marshal to
+// JSON.
+type MarshallingError struct {
+	Err           error
+	CallingMethod string
+	Params        interface{}
+}
+
+func newMarshallingError(err error, callingMethod string, params interface{}) *MarshallingError {
+	return &MarshallingError{Err: err, CallingMethod: callingMethod, Params: params}
+}
+
+func (e *MarshallingError) Error() string {
+	return fmt.Sprintf("goselenium: %s: failed to marshal request body %+v: %v", e.CallingMethod, e.Params, e.Err)
+}
+
+func (e *MarshallingError) Unwrap() error {
+	return e.Err
+}
+
+// UnmarshallingError is returned when a response body from the remote end
+// fails to unmarshal from JSON.
+type UnmarshallingError struct {
+	Err           error
+	CallingMethod string
+	Body          string
+}
+
+func newUnmarshallingError(err error, callingMethod, body string) *UnmarshallingError {
+	return &UnmarshallingError{Err: err, CallingMethod: callingMethod, Body: body}
+}
+
+func (e *UnmarshallingError) Error() string {
+	return fmt.Sprintf("goselenium: %s: failed to unmarshal response %q: %v", e.CallingMethod, e.Body, e.Err)
+}
+
+func (e *UnmarshallingError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidByParameterError is returned when a By is used with a command that
+// only supports a subset of locator strategies, such as SwitchToFrame, which
+// only accepts ByIndex.
+type InvalidByParameterError struct {
+	CallingMethod string
+	ByType        string
+}
+
+func newInvalidByParameterError(callingMethod, byType string) *InvalidByParameterError {
+	return &InvalidByParameterError{CallingMethod: callingMethod, ByType: byType}
+}
+
+func (e *InvalidByParameterError) Error() string {
+	return fmt.Sprintf("goselenium: %s: unsupported By type %q", e.CallingMethod, e.ByType)
+}
+
+// ServiceStartError is returned when a local Service (chromedriver,
+// geckodriver, selenium-server.jar) or FrameBuffer fails to start or never
+// reports itself ready.
+type ServiceStartError struct {
+	Err  error
+	Path string
+}
+
+func newServiceStartError(err error, path string) *ServiceStartError {
+	return &ServiceStartError{Err: err, Path: path}
+}
+
+func (e *ServiceStartError) Error() string {
+	return fmt.Sprintf("goselenium: failed to start %s: %v", e.Path, e.Err)
+}
+
+func (e *ServiceStartError) Unwrap() error {
+	return e.Err
+}
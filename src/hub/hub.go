@@ -0,0 +1,182 @@
+// Package hub turns this module from a client-only library into a
+// foundation for building Selenoid-like grids: it exposes an http.Handler
+// that speaks the W3C wire protocol on the front and multiplexes one or
+// more upstream WebDriver sessions behind it, so that existing Selenium
+// clients in any language can point at a Go process built on this package
+// for policy, quota, session affinity, and command rewriting.
+package hub
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	goselenium "github.com/jaytarang92/go-selenium/src"
+)
+
+// Request is the inbound command a Handler dispatches: the sessionId
+// extracted from the URL plus the raw method/path/body, shaped closely
+// after this module's internal request type.
+type Request struct {
+	SessionID string
+	Method    string
+	Path      string
+	Body      []byte
+}
+
+// Response is what a HandlerFunc or Upstream returns for a Request.
+type Response struct {
+	Status int
+	Body   []byte
+}
+
+// HandlerFunc is a single middleware in the chain a Handler dispatches a
+// Request through before falling back to its Upstream. Returning a non-nil
+// Response short-circuits the chain; returning (nil, nil) passes the
+// request to the next middleware (or the upstream, if it was the last one).
+type HandlerFunc func(ctx context.Context, req Request) (*Response, error)
+
+// Upstream is what a Handler forwards unmatched commands to.
+type Upstream interface {
+	// Forward sends req verbatim to the upstream and returns its response.
+	Forward(ctx context.Context, req Request) (*Response, error)
+}
+
+// Handler is an http.Handler that multiplexes one or more upstream
+// WebDriver sessions behind a single address. Commands are routed by the
+// sessionId segment of the URL path, run through the registered
+// middlewares in order, and forwarded to Upstream verbatim if none of them
+// answer.
+type Handler struct {
+	upstream    Upstream
+	middlewares []HandlerFunc
+}
+
+// NewHandler returns a Handler that forwards unmatched commands to upstream.
+func NewHandler(upstream Upstream) *Handler {
+	return &Handler{upstream: upstream}
+}
+
+// Use registers a middleware, run in registration order ahead of Upstream.
+func (h *Handler) Use(fn HandlerFunc) {
+	h.middlewares = append(h.middlewares, fn)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := Request{
+		SessionID: sessionIDFromPath(r.URL.Path),
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Body:      body,
+	}
+
+	resp, err := h.dispatch(r.Context(), req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.Status)
+	w.Write(resp.Body)
+}
+
+func (h *Handler) dispatch(ctx context.Context, req Request) (*Response, error) {
+	for _, middleware := range h.middlewares {
+		resp, err := middleware(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if resp != nil {
+			return resp, nil
+		}
+	}
+
+	return h.upstream.Forward(ctx, req)
+}
+
+// sessionIDFromPath extracts the sessionId path segment from a W3C wire
+// protocol URL, e.g. "/session/abc123/url" -> "abc123". It returns "" for
+// paths with no session segment, such as "/session" (new session) or
+// "/status".
+func sessionIDFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "session" && i+1 < len(segments) {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// TransportUpstream forwards requests to a single upstream WebDriver server
+// using a goselenium.Transport, the same pluggable HTTP layer WebDriver
+// itself uses.
+type TransportUpstream struct {
+	BaseURL   string
+	Transport goselenium.Transport
+}
+
+// Forward implements Upstream.
+func (u *TransportUpstream) Forward(ctx context.Context, req Request) (*Response, error) {
+	var body io.Reader
+	if len(req.Body) > 0 {
+		body = bytes.NewReader(req.Body)
+	}
+
+	respBody, err := u.Transport.Do(ctx, req.Method, u.BaseURL+req.Path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{Status: http.StatusOK, Body: respBody}, nil
+}
+
+// Pool is an Upstream that multiplexes several backends, picking one per
+// request via Assign. It lets a Handler spread sessions across more than
+// one real driver/grid instance while still exposing them as a single
+// address.
+type Pool struct {
+	Backends []Upstream
+
+	// Assign picks which backend should handle req. It defaults to hashing
+	// SessionID across backends, which keeps every command for a given
+	// session on the same backend.
+	Assign func(req Request, backends []Upstream) Upstream
+}
+
+// Forward implements Upstream.
+func (p *Pool) Forward(ctx context.Context, req Request) (*Response, error) {
+	if len(p.Backends) == 0 {
+		return nil, errors.New("hub: pool has no backends")
+	}
+
+	assign := p.Assign
+	if assign == nil {
+		assign = assignBySessionID
+	}
+
+	return assign(req, p.Backends).Forward(ctx, req)
+}
+
+func assignBySessionID(req Request, backends []Upstream) Upstream {
+	if req.SessionID == "" {
+		return backends[0]
+	}
+
+	sum := 0
+	for _, r := range req.SessionID {
+		sum += int(r)
+	}
+	return backends[sum%len(backends)]
+}
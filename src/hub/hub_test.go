@@ -0,0 +1,238 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestSessionIDFromPath(t *testing.T) {
+	cases := []struct {
+		path string
+		want string
+	}{
+		{"/session", ""},
+		{"/status", ""},
+		{"", ""},
+		{"/session/abc123", "abc123"},
+		{"/session/abc123/url", "abc123"},
+		{"/session/abc123/", "abc123"},
+		{"session/abc123/element/e1/click", "abc123"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.path, func(t *testing.T) {
+			if got := sessionIDFromPath(c.path); got != c.want {
+				t.Errorf("sessionIDFromPath(%q) = %q, want %q", c.path, got, c.want)
+			}
+		})
+	}
+}
+
+// fakeUpstream records the requests it receives and returns a fixed
+// Response/error pair.
+type fakeUpstream struct {
+	resp     *Response
+	err      error
+	requests []Request
+}
+
+func (u *fakeUpstream) Forward(ctx context.Context, req Request) (*Response, error) {
+	u.requests = append(u.requests, req)
+	return u.resp, u.err
+}
+
+func TestAssignBySessionIDRoutesConsistently(t *testing.T) {
+	backends := []Upstream{&fakeUpstream{}, &fakeUpstream{}, &fakeUpstream{}}
+
+	first := assignBySessionID(Request{SessionID: "abc123"}, backends)
+	second := assignBySessionID(Request{SessionID: "abc123"}, backends)
+	if first != second {
+		t.Error("assignBySessionID returned different backends for the same session ID")
+	}
+}
+
+func TestAssignBySessionIDWithNoSessionUsesFirstBackend(t *testing.T) {
+	backends := []Upstream{&fakeUpstream{}, &fakeUpstream{}}
+
+	if got := assignBySessionID(Request{SessionID: ""}, backends); got != backends[0] {
+		t.Error("assignBySessionID() with no session ID did not return the first backend")
+	}
+}
+
+func TestHandlerMiddlewarePassesThroughOnNilResponse(t *testing.T) {
+	upstream := &fakeUpstream{resp: &Response{Status: 200, Body: []byte("from upstream")}}
+	h := NewHandler(upstream)
+
+	var called bool
+	h.Use(func(ctx context.Context, req Request) (*Response, error) {
+		called = true
+		return nil, nil
+	})
+
+	resp, err := h.dispatch(context.Background(), Request{Path: "/status"})
+	if err != nil {
+		t.Fatalf("dispatch() returned error: %v", err)
+	}
+	if !called {
+		t.Error("middleware returning (nil, nil) was not invoked")
+	}
+	if string(resp.Body) != "from upstream" {
+		t.Errorf("Body = %q, want %q", resp.Body, "from upstream")
+	}
+	if len(upstream.requests) != 1 {
+		t.Errorf("upstream.Forward called %d times, want 1", len(upstream.requests))
+	}
+}
+
+func TestHandlerMiddlewareShortCircuitsOnResponse(t *testing.T) {
+	upstream := &fakeUpstream{resp: &Response{Status: 200}}
+	h := NewHandler(upstream)
+
+	h.Use(func(ctx context.Context, req Request) (*Response, error) {
+		return &Response{Status: 429, Body: []byte("rate limited")}, nil
+	})
+	h.Use(func(ctx context.Context, req Request) (*Response, error) {
+		t.Fatal("second middleware must not run once an earlier one answered")
+		return nil, nil
+	})
+
+	resp, err := h.dispatch(context.Background(), Request{Path: "/status"})
+	if err != nil {
+		t.Fatalf("dispatch() returned error: %v", err)
+	}
+	if resp.Status != 429 || string(resp.Body) != "rate limited" {
+		t.Errorf("resp = %+v, want the first middleware's response", resp)
+	}
+	if len(upstream.requests) != 0 {
+		t.Error("upstream was called even though a middleware short-circuited the chain")
+	}
+}
+
+func TestHandlerMiddlewareShortCircuitsOnError(t *testing.T) {
+	upstream := &fakeUpstream{resp: &Response{Status: 200}}
+	h := NewHandler(upstream)
+
+	wantErr := errors.New("middleware blew up")
+	h.Use(func(ctx context.Context, req Request) (*Response, error) {
+		return nil, wantErr
+	})
+	h.Use(func(ctx context.Context, req Request) (*Response, error) {
+		t.Fatal("second middleware must not run once an earlier one errored")
+		return nil, nil
+	})
+
+	_, err := h.dispatch(context.Background(), Request{Path: "/status"})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("dispatch() error = %v, want %v", err, wantErr)
+	}
+	if len(upstream.requests) != 0 {
+		t.Error("upstream was called even though a middleware errored")
+	}
+}
+
+func TestPoolForwardWithNoBackendsErrors(t *testing.T) {
+	pool := &Pool{}
+
+	if _, err := pool.Forward(context.Background(), Request{}); err == nil {
+		t.Error("Pool.Forward() with no backends returned nil error, want an error")
+	}
+}
+
+func TestPoolForwardUsesAssign(t *testing.T) {
+	chosen := &fakeUpstream{resp: &Response{Status: 200, Body: []byte("chosen")}}
+	other := &fakeUpstream{resp: &Response{Status: 200, Body: []byte("other")}}
+
+	pool := &Pool{
+		Backends: []Upstream{other, chosen},
+		Assign: func(req Request, backends []Upstream) Upstream {
+			return chosen
+		},
+	}
+
+	resp, err := pool.Forward(context.Background(), Request{SessionID: "abc"})
+	if err != nil {
+		t.Fatalf("Pool.Forward() returned error: %v", err)
+	}
+	if string(resp.Body) != "chosen" {
+		t.Errorf("Body = %q, want %q", resp.Body, "chosen")
+	}
+	if len(chosen.requests) != 1 {
+		t.Error("the backend picked by Assign was not called")
+	}
+	if len(other.requests) != 0 {
+		t.Error("a backend not picked by Assign was called")
+	}
+}
+
+func TestPoolForwardDefaultsToAssignBySessionID(t *testing.T) {
+	backends := []Upstream{&fakeUpstream{resp: &Response{}}, &fakeUpstream{resp: &Response{}}, &fakeUpstream{resp: &Response{}}}
+	pool := &Pool{Backends: backends}
+
+	want := assignBySessionID(Request{SessionID: "abc123"}, backends)
+	if _, err := pool.Forward(context.Background(), Request{SessionID: "abc123"}); err != nil {
+		t.Fatalf("Pool.Forward() returned error: %v", err)
+	}
+
+	wantFake := want.(*fakeUpstream)
+	if len(wantFake.requests) != 1 {
+		t.Error("Pool.Forward() did not route to the backend assignBySessionID would pick")
+	}
+}
+
+// fakeTransport implements goselenium.Transport for TransportUpstream tests.
+type fakeTransport struct {
+	gotMethod string
+	gotURL    string
+	gotBody   []byte
+	resp      []byte
+	err       error
+}
+
+func (f *fakeTransport) Do(ctx context.Context, method, url string, body io.Reader) ([]byte, error) {
+	f.gotMethod = method
+	f.gotURL = url
+	if body != nil {
+		f.gotBody, _ = io.ReadAll(body)
+	}
+	return f.resp, f.err
+}
+
+func TestTransportUpstreamForward(t *testing.T) {
+	transport := &fakeTransport{resp: []byte(`{"value":null}`)}
+	upstream := &TransportUpstream{BaseURL: "http://grid.internal:4444", Transport: transport}
+
+	resp, err := upstream.Forward(context.Background(), Request{
+		Method: "POST",
+		Path:   "/session/abc123/url",
+		Body:   []byte(`{"url":"http://example.com"}`),
+	})
+	if err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+	if resp.Status != 200 {
+		t.Errorf("Status = %d, want 200", resp.Status)
+	}
+	if string(resp.Body) != `{"value":null}` {
+		t.Errorf("Body = %s, want %s", resp.Body, `{"value":null}`)
+	}
+	if transport.gotMethod != "POST" {
+		t.Errorf("method = %q, want POST", transport.gotMethod)
+	}
+	if transport.gotURL != "http://grid.internal:4444/session/abc123/url" {
+		t.Errorf("url = %q, want %q", transport.gotURL, "http://grid.internal:4444/session/abc123/url")
+	}
+	if string(transport.gotBody) != `{"url":"http://example.com"}` {
+		t.Errorf("body = %s, want %s", transport.gotBody, `{"url":"http://example.com"}`)
+	}
+}
+
+func TestTransportUpstreamForwardPropagatesError(t *testing.T) {
+	wantErr := errors.New("connection refused")
+	upstream := &TransportUpstream{BaseURL: "http://grid.internal:4444", Transport: &fakeTransport{err: wantErr}}
+
+	if _, err := upstream.Forward(context.Background(), Request{Method: "GET", Path: "/status"}); !errors.Is(err, wantErr) {
+		t.Errorf("Forward() error = %v, want %v", err, wantErr)
+	}
+}
@@ -1,15 +1,20 @@
 package goselenium
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
-// NewSeleniumWebDriver creates a new instance of a Selenium web driver.
-func NewSeleniumWebDriver(serviceURL string, capabilities Capabilities) (WebDriver, error) {
+// NewSeleniumWebDriver creates a new instance of a Selenium web driver. By
+// default it talks HTTP through the standard library's http.Client; pass
+// DriverOptions (e.g. WithHTTPClient, WithRetry, WithTransport) to customise
+// that behaviour.
+func NewSeleniumWebDriver(serviceURL string, capabilities Capabilities, opts ...DriverOption) (WebDriver, error) {
 	if serviceURL == "" {
 		return nil, errors.New("Provided Selenium URL is invalid")
 	}
@@ -29,10 +34,15 @@ func NewSeleniumWebDriver(serviceURL string, capabilities Capabilities) (WebDriv
 		serviceURL = strings.TrimSuffix(serviceURL, "/")
 	}
 
+	cfg := defaultDriverConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	driver := &seleniumWebDriver{
 		seleniumURL:  serviceURL,
 		capabilities: &capabilities,
-		apiService:   &seleniumAPIService{},
+		apiService:   cfg.buildTransport(),
 	}
 
 	return driver, nil
@@ -70,7 +80,7 @@ func SessionImplicitWaitTimeout(to int) Timeout {
 // specification).
 func ByIndex(index uint) (By, error) {
 	if index > 65535 {
-		return nil, newInvalidArgumentError("Index out of range in ByIndex()", "index", string(index))
+		return nil, newInvalidArgumentError("Index out of range in ByIndex()", "index", fmt.Sprint(index))
 	}
 
 	by := &by{
@@ -100,17 +110,18 @@ type seleniumWebDriver struct {
 	sessionID    string
 	capabilities *Capabilities
 	apiService   apiService
+	protocol     protocol
 }
 
 func (s *seleniumWebDriver) DriverURL() string {
 	return s.seleniumURL
 }
 
-func (s *seleniumWebDriver) stateRequest(req *request) (*stateResponse, error) {
+func (s *seleniumWebDriver) stateRequest(ctx context.Context, req *request) (*stateResponse, error) {
 	var response stateResponse
 	var err error
 
-	resp, err := s.apiService.performRequest(req.url, req.method, req.body)
+	resp, err := s.apiService.Do(ctx, req.method, req.url, req.body)
 	if err != nil {
 		return nil, newCommunicationError(err, req.callingMethod, req.url, resp)
 	}
@@ -123,11 +134,11 @@ func (s *seleniumWebDriver) stateRequest(req *request) (*stateResponse, error) {
 	return &response, nil
 }
 
-func (s *seleniumWebDriver) valueRequest(req *request) (*valueResponse, error) {
+func (s *seleniumWebDriver) valueRequest(ctx context.Context, req *request) (*valueResponse, error) {
 	var response valueResponse
 	var err error
 
-	resp, err := s.apiService.performRequest(req.url, req.method, req.body)
+	resp, err := s.apiService.Do(ctx, req.method, req.url, req.body)
 	if err != nil {
 		return nil, newCommunicationError(err, req.callingMethod, req.url, resp)
 	}
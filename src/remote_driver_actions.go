@@ -0,0 +1,153 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// PerformActionsResponse is the response returned from the PerformActions
+// call.
+type PerformActionsResponse struct {
+	State string
+}
+
+// ReleaseActionsResponse is the response returned from the ReleaseActions
+// call.
+type ReleaseActionsResponse struct {
+	State string
+}
+
+// ActionsBuilder builds the chained pointer and key input sequences sent by
+// PerformActions, modelled on the W3C actions API. Calls can be chained:
+//
+//	goselenium.NewActionsBuilder().
+//		MoveTo(10, 10).
+//		PointerDown(0).
+//		PointerUp(0).
+//		KeyDown("a").
+//		KeyUp("a")
+type ActionsBuilder struct {
+	pointerActions []map[string]interface{}
+	keyActions     []map[string]interface{}
+}
+
+// NewActionsBuilder returns an empty ActionsBuilder.
+func NewActionsBuilder() *ActionsBuilder {
+	return &ActionsBuilder{}
+}
+
+// MoveTo appends a pointer move to the given viewport coordinates.
+func (b *ActionsBuilder) MoveTo(x, y int) *ActionsBuilder {
+	b.pointerActions = append(b.pointerActions, map[string]interface{}{
+		"type": "pointerMove", "duration": 0, "x": x, "y": y,
+	})
+	return b
+}
+
+// PointerDown appends a pointer button press, where button follows the
+// W3C convention (0 is the left/primary button).
+func (b *ActionsBuilder) PointerDown(button int) *ActionsBuilder {
+	b.pointerActions = append(b.pointerActions, map[string]interface{}{
+		"type": "pointerDown", "button": button,
+	})
+	return b
+}
+
+// PointerUp appends a pointer button release.
+func (b *ActionsBuilder) PointerUp(button int) *ActionsBuilder {
+	b.pointerActions = append(b.pointerActions, map[string]interface{}{
+		"type": "pointerUp", "button": button,
+	})
+	return b
+}
+
+// KeyDown appends a key press. key should be a single unicode code point,
+// per the W3C spec (e.g. "a" or "A"; special keys such as Enter use code
+// points from the Unicode private use area).
+func (b *ActionsBuilder) KeyDown(key string) *ActionsBuilder {
+	b.keyActions = append(b.keyActions, map[string]interface{}{
+		"type": "keyDown", "value": key,
+	})
+	return b
+}
+
+// KeyUp appends a key release.
+func (b *ActionsBuilder) KeyUp(key string) *ActionsBuilder {
+	b.keyActions = append(b.keyActions, map[string]interface{}{
+		"type": "keyUp", "value": key,
+	})
+	return b
+}
+
+// build renders the accumulated actions into the W3C "actions" input source
+// array, one entry per input device that was actually used.
+func (b *ActionsBuilder) build() []map[string]interface{} {
+	var sequences []map[string]interface{}
+
+	if len(b.pointerActions) > 0 {
+		sequences = append(sequences, map[string]interface{}{
+			"type":       "pointer",
+			"id":         "mouse",
+			"parameters": map[string]interface{}{"pointerType": "mouse"},
+			"actions":    b.pointerActions,
+		})
+	}
+
+	if len(b.keyActions) > 0 {
+		sequences = append(sequences, map[string]interface{}{
+			"type":    "key",
+			"id":      "keyboard",
+			"actions": b.keyActions,
+		})
+	}
+
+	return sequences
+}
+
+func (s *seleniumWebDriver) PerformActions(ctx context.Context, actions *ActionsBuilder) (*PerformActionsResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/actions", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("PerformActions()")
+	}
+
+	params := map[string]interface{}{"actions": actions.build()}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "PerformActions()", params)
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader(marshalledJSON),
+		callingMethod: "PerformActions",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PerformActionsResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) ReleaseActions(ctx context.Context) (*ReleaseActionsResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/actions", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("ReleaseActions()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "DELETE",
+		body:          nil,
+		callingMethod: "ReleaseActions",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReleaseActionsResponse{State: resp.State}, nil
+}
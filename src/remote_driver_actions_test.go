@@ -0,0 +1,74 @@
+package goselenium
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestActionsBuilderBuild(t *testing.T) {
+	sequences := NewActionsBuilder().
+		MoveTo(10, 20).
+		PointerDown(0).
+		PointerUp(0).
+		KeyDown("a").
+		KeyUp("a").
+		build()
+
+	if len(sequences) != 2 {
+		t.Fatalf("len(sequences) = %d, want 2 (one pointer, one key)", len(sequences))
+	}
+	if sequences[0]["type"] != "pointer" {
+		t.Errorf("sequences[0][\"type\"] = %v, want \"pointer\"", sequences[0]["type"])
+	}
+	if sequences[1]["type"] != "key" {
+		t.Errorf("sequences[1][\"type\"] = %v, want \"key\"", sequences[1]["type"])
+	}
+}
+
+func TestActionsBuilderBuildOmitsUnusedDevices(t *testing.T) {
+	sequences := NewActionsBuilder().KeyDown("a").build()
+
+	if len(sequences) != 1 {
+		t.Fatalf("len(sequences) = %d, want 1 (no pointer actions were added)", len(sequences))
+	}
+	if sequences[0]["type"] != "key" {
+		t.Errorf("sequences[0][\"type\"] = %v, want \"key\"", sequences[0]["type"])
+	}
+}
+
+func TestPerformActions(t *testing.T) {
+	var gotBody struct {
+		Actions []map[string]interface{} `json:"actions"`
+	}
+
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/actions" {
+			t.Errorf("path = %q, want /session/test-session/actions", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	actions := NewActionsBuilder().MoveTo(1, 2)
+	if _, err := driver.PerformActions(context.Background(), actions); err != nil {
+		t.Fatalf("PerformActions() returned error: %v", err)
+	}
+	if len(gotBody.Actions) != 1 {
+		t.Errorf("len(Actions) = %d, want 1", len(gotBody.Actions))
+	}
+}
+
+func TestReleaseActions(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("method = %q, want DELETE", r.Method)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.ReleaseActions(context.Background()); err != nil {
+		t.Fatalf("ReleaseActions() returned error: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// AcceptAlertResponse is the response returned from the AcceptAlert call.
+type AcceptAlertResponse struct {
+	State string
+}
+
+// DismissAlertResponse is the response returned from the DismissAlert call.
+type DismissAlertResponse struct {
+	State string
+}
+
+// AlertTextResponse is the response returned from the AlertText call.
+type AlertTextResponse struct {
+	State string
+	Text  string
+}
+
+// SendAlertTextResponse is the response returned from the SendAlertText call.
+type SendAlertTextResponse struct {
+	State string
+}
+
+func (s *seleniumWebDriver) AcceptAlert(ctx context.Context) (*AcceptAlertResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/alert/accept", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("AcceptAlert()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader([]byte("{}")),
+		callingMethod: "AcceptAlert",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcceptAlertResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) DismissAlert(ctx context.Context) (*DismissAlertResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/alert/dismiss", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("DismissAlert()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader([]byte("{}")),
+		callingMethod: "DismissAlert",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DismissAlertResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) AlertText(ctx context.Context) (*AlertTextResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/alert/text", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("AlertText()")
+	}
+
+	resp, err := s.valueRequest(ctx, &request{
+		url:           url,
+		method:        "GET",
+		body:          nil,
+		callingMethod: "AlertText",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AlertTextResponse{State: resp.State, Text: resp.Value}, nil
+}
+
+func (s *seleniumWebDriver) SendAlertText(ctx context.Context, text string) (*SendAlertTextResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/alert/text", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("SendAlertText()")
+	}
+
+	params := map[string]string{"text": text}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "SendAlertText()", params)
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader(marshalledJSON),
+		callingMethod: "SendAlertText",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SendAlertTextResponse{State: resp.State}, nil
+}
+
+// hasAlert satisfies the alertChecker interface used by the AlertPresent
+// Condition. An ErrNoSuchAlert error means no dialog is open; any other
+// error is propagated.
+func (s *seleniumWebDriver) hasAlert(ctx context.Context) (bool, error) {
+	_, err := s.AlertText(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrNoSuchAlert) {
+		return false, nil
+	}
+	return false, err
+}
@@ -0,0 +1,86 @@
+package goselenium
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAcceptAlert(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/alert/accept" {
+			t.Errorf("path = %q, want /session/test-session/alert/accept", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.AcceptAlert(context.Background()); err != nil {
+		t.Fatalf("AcceptAlert() returned error: %v", err)
+	}
+}
+
+func TestDismissAlert(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/alert/dismiss" {
+			t.Errorf("path = %q, want /session/test-session/alert/dismiss", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.DismissAlert(context.Background()); err != nil {
+		t.Fatalf("DismissAlert() returned error: %v", err)
+	}
+}
+
+func TestSendAlertText(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method = %q, want POST", r.Method)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.SendAlertText(context.Background(), "hello"); err != nil {
+		t.Fatalf("SendAlertText() returned error: %v", err)
+	}
+}
+
+func TestHasAlertOpen(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"state":"success","value":"are you sure?"}`))
+	})
+
+	open, err := driver.hasAlert(context.Background())
+	if err != nil {
+		t.Fatalf("hasAlert() returned error: %v", err)
+	}
+	if !open {
+		t.Error("hasAlert() = false, want true")
+	}
+}
+
+func TestHasAlertNoneOpen(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"value":{"error":"no such alert","message":"no such alert"}}`))
+	})
+
+	open, err := driver.hasAlert(context.Background())
+	if err != nil {
+		t.Fatalf("hasAlert() returned error: %v", err)
+	}
+	if open {
+		t.Error("hasAlert() = true, want false")
+	}
+}
+
+func TestHasAlertPropagatesOtherErrors(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"value":{"error":"unknown error","message":"something else broke"}}`))
+	})
+
+	if _, err := driver.hasAlert(context.Background()); err == nil {
+		t.Error("hasAlert() = nil error, want the unknown error to be propagated")
+	}
+}
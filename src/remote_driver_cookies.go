@@ -0,0 +1,161 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Cookie represents a W3C cookie object, as sent to AddCookie and returned
+// by GetCookie/GetCookies.
+type Cookie struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Path     string `json:"path,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+	Secure   bool   `json:"secure,omitempty"`
+	HTTPOnly bool   `json:"httpOnly,omitempty"`
+	Expiry   int64  `json:"expiry,omitempty"`
+	SameSite string `json:"sameSite,omitempty"`
+}
+
+// AddCookieResponse is the response returned from the AddCookie call.
+type AddCookieResponse struct {
+	State string
+}
+
+// GetCookieResponse is the response returned from the GetCookie call.
+type GetCookieResponse struct {
+	State  string
+	Cookie Cookie
+}
+
+// GetCookiesResponse is the response returned from the GetCookies call.
+type GetCookiesResponse struct {
+	State   string
+	Cookies []Cookie
+}
+
+// DeleteCookieResponse is the response returned from the DeleteCookie call.
+type DeleteCookieResponse struct {
+	State string
+}
+
+// DeleteAllCookiesResponse is the response returned from the
+// DeleteAllCookies call.
+type DeleteAllCookiesResponse struct {
+	State string
+}
+
+func (s *seleniumWebDriver) AddCookie(ctx context.Context, cookie Cookie) (*AddCookieResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/cookie", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("AddCookie()")
+	}
+
+	params := map[string]interface{}{"cookie": cookie}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "AddCookie()", params)
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader(marshalledJSON),
+		callingMethod: "AddCookie",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddCookieResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) GetCookie(ctx context.Context, name string) (*GetCookieResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/cookie/%s", s.seleniumURL, s.sessionID, name)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("GetCookie()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "GetCookie", url, resp)
+	}
+
+	var response struct {
+		Value Cookie `json:"value"`
+	}
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "GetCookie", string(resp))
+	}
+
+	return &GetCookieResponse{State: "success", Cookie: response.Value}, nil
+}
+
+func (s *seleniumWebDriver) GetCookies(ctx context.Context) (*GetCookiesResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/cookie", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("GetCookies()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "GetCookies", url, resp)
+	}
+
+	var response struct {
+		Value []Cookie `json:"value"`
+	}
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "GetCookies", string(resp))
+	}
+
+	return &GetCookiesResponse{State: "success", Cookies: response.Value}, nil
+}
+
+func (s *seleniumWebDriver) DeleteCookie(ctx context.Context, name string) (*DeleteCookieResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/cookie/%s", s.seleniumURL, s.sessionID, name)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("DeleteCookie()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "DELETE",
+		body:          nil,
+		callingMethod: "DeleteCookie",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteCookieResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) DeleteAllCookies(ctx context.Context) (*DeleteAllCookiesResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/cookie", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("DeleteAllCookies()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "DELETE",
+		body:          nil,
+		callingMethod: "DeleteAllCookies",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeleteAllCookiesResponse{State: resp.State}, nil
+}
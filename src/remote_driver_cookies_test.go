@@ -0,0 +1,130 @@
+package goselenium
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestDriver(t *testing.T, handler http.HandlerFunc) *seleniumWebDriver {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	return &seleniumWebDriver{
+		seleniumURL: ts.URL,
+		sessionID:   "test-session",
+		apiService:  defaultDriverConfig().buildTransport(),
+	}
+}
+
+func TestAddCookie(t *testing.T) {
+	var gotPath, gotMethod string
+	var gotBody struct {
+		Cookie Cookie `json:"cookie"`
+	}
+
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	cookie := Cookie{Name: "session", Value: "abc123", Path: "/"}
+	if _, err := driver.AddCookie(context.Background(), cookie); err != nil {
+		t.Fatalf("AddCookie() returned error: %v", err)
+	}
+
+	if gotMethod != "POST" {
+		t.Errorf("method = %q, want POST", gotMethod)
+	}
+	if gotPath != "/session/test-session/cookie" {
+		t.Errorf("path = %q, want /session/test-session/cookie", gotPath)
+	}
+	if gotBody.Cookie != cookie {
+		t.Errorf("request body cookie = %+v, want %+v", gotBody.Cookie, cookie)
+	}
+}
+
+func TestGetCookie(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/cookie/session" {
+			t.Errorf("path = %q, want /session/test-session/cookie/session", r.URL.Path)
+		}
+		w.Write([]byte(`{"value":{"name":"session","value":"abc123"}}`))
+	})
+
+	resp, err := driver.GetCookie(context.Background(), "session")
+	if err != nil {
+		t.Fatalf("GetCookie() returned error: %v", err)
+	}
+	if resp.Cookie.Name != "session" || resp.Cookie.Value != "abc123" {
+		t.Errorf("Cookie = %+v, want {Name: session, Value: abc123}", resp.Cookie)
+	}
+}
+
+func TestGetCookies(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"value":[{"name":"a","value":"1"},{"name":"b","value":"2"}]}`))
+	})
+
+	resp, err := driver.GetCookies(context.Background())
+	if err != nil {
+		t.Fatalf("GetCookies() returned error: %v", err)
+	}
+	if len(resp.Cookies) != 2 {
+		t.Fatalf("len(Cookies) = %d, want 2", len(resp.Cookies))
+	}
+}
+
+func TestDeleteCookie(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("method = %q, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/session/test-session/cookie/session" {
+			t.Errorf("path = %q, want /session/test-session/cookie/session", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.DeleteCookie(context.Background(), "session"); err != nil {
+		t.Fatalf("DeleteCookie() returned error: %v", err)
+	}
+}
+
+func TestDeleteAllCookies(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/cookie" {
+			t.Errorf("path = %q, want /session/test-session/cookie", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":null}`))
+	})
+
+	if _, err := driver.DeleteAllCookies(context.Background()); err != nil {
+		t.Fatalf("DeleteAllCookies() returned error: %v", err)
+	}
+}
+
+func TestCookieCallsRequireSession(t *testing.T) {
+	driver := &seleniumWebDriver{seleniumURL: "http://example.invalid"}
+
+	if _, err := driver.AddCookie(context.Background(), Cookie{}); err == nil {
+		t.Error("AddCookie() with no session = nil error, want SessionIDError")
+	}
+	if _, err := driver.GetCookie(context.Background(), "x"); err == nil {
+		t.Error("GetCookie() with no session = nil error, want SessionIDError")
+	}
+	if _, err := driver.GetCookies(context.Background()); err == nil {
+		t.Error("GetCookies() with no session = nil error, want SessionIDError")
+	}
+	if _, err := driver.DeleteCookie(context.Background(), "x"); err == nil {
+		t.Error("DeleteCookie() with no session = nil error, want SessionIDError")
+	}
+	if _, err := driver.DeleteAllCookies(context.Background()); err == nil {
+		t.Error("DeleteAllCookies() with no session = nil error, want SessionIDError")
+	}
+}
@@ -0,0 +1,222 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// webElementKey is the W3C defined key used to identify element references
+// in JSON request/response bodies.
+const webElementKey = "element-6066-11e4-a52e-4f2f5f7f37e5"
+
+// FindElementResponse is the response returned from the FindElement call.
+type FindElementResponse struct {
+	State   string
+	Element WebElement
+}
+
+// FindElementsResponse is the response returned from the FindElements call.
+type FindElementsResponse struct {
+	State    string
+	Elements []WebElement
+}
+
+// ElementClickResponse is the response returned from a WebElement's Click call.
+type ElementClickResponse struct {
+	State string
+}
+
+// ElementDisplayedResponse is the response returned from a WebElement's
+// Displayed call.
+type ElementDisplayedResponse struct {
+	State     string
+	Displayed bool
+}
+
+// ElementEnabledResponse is the response returned from a WebElement's
+// Enabled call.
+type ElementEnabledResponse struct {
+	State   string
+	Enabled bool
+}
+
+type elementReference struct {
+	ID string `json:"element-6066-11e4-a52e-4f2f5f7f37e5"`
+}
+
+type elementValueResponse struct {
+	State string           `json:"state"`
+	Value elementReference `json:"value"`
+}
+
+type elementsValueResponse struct {
+	State string             `json:"state"`
+	Value []elementReference `json:"value"`
+}
+
+type booleanValueResponse struct {
+	State string `json:"state"`
+	Value bool   `json:"value"`
+}
+
+func (s *seleniumWebDriver) FindElement(ctx context.Context, by By) (WebElement, error) {
+	var err error
+
+	url := fmt.Sprintf("%s/session/%s/element", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("FindElement()")
+	}
+
+	using, value := locatorStrategy(by, s.protocol)
+	params := map[string]interface{}{
+		"using": using,
+		"value": value,
+	}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "FindElement()", params)
+	}
+
+	resp, err := s.apiService.Do(ctx, "POST", url, bytes.NewReader(marshalledJSON))
+	if err != nil {
+		return nil, newCommunicationError(err, "FindElement", url, resp)
+	}
+
+	var response elementValueResponse
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "FindElement", string(resp))
+	}
+
+	return &webElement{
+		id:     response.Value.ID,
+		driver: s,
+	}, nil
+}
+
+func (s *seleniumWebDriver) FindElements(ctx context.Context, by By) ([]WebElement, error) {
+	var err error
+
+	url := fmt.Sprintf("%s/session/%s/elements", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("FindElements()")
+	}
+
+	using, value := locatorStrategy(by, s.protocol)
+	params := map[string]interface{}{
+		"using": using,
+		"value": value,
+	}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "FindElements()", params)
+	}
+
+	resp, err := s.apiService.Do(ctx, "POST", url, bytes.NewReader(marshalledJSON))
+	if err != nil {
+		return nil, newCommunicationError(err, "FindElements", url, resp)
+	}
+
+	var response elementsValueResponse
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "FindElements", string(resp))
+	}
+
+	elements := make([]WebElement, 0, len(response.Value))
+	for _, ref := range response.Value {
+		elements = append(elements, &webElement{
+			id:     ref.ID,
+			driver: s,
+		})
+	}
+
+	return elements, nil
+}
+
+// webElement is the default implementation of WebElement, scoped to the
+// session of the WebDriver that found it.
+type webElement struct {
+	id     string
+	driver *seleniumWebDriver
+}
+
+func (e *webElement) ID() string {
+	return e.id
+}
+
+func (e *webElement) Click(ctx context.Context) (*ElementClickResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/element/%s/click", e.driver.seleniumURL, e.driver.sessionID, e.id)
+
+	resp, err := e.driver.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader([]byte("{}")),
+		callingMethod: "Click",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ElementClickResponse{State: resp.State}, nil
+}
+
+func (e *webElement) Displayed(ctx context.Context) (*ElementDisplayedResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/element/%s/displayed", e.driver.seleniumURL, e.driver.sessionID, e.id)
+
+	resp, err := e.driver.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "Displayed", url, resp)
+	}
+
+	var response booleanValueResponse
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "Displayed", string(resp))
+	}
+
+	return &ElementDisplayedResponse{State: response.State, Displayed: response.Value}, nil
+}
+
+func (e *webElement) Enabled(ctx context.Context) (*ElementEnabledResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/element/%s/enabled", e.driver.seleniumURL, e.driver.sessionID, e.id)
+
+	resp, err := e.driver.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "Enabled", url, resp)
+	}
+
+	var response booleanValueResponse
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "Enabled", string(resp))
+	}
+
+	return &ElementEnabledResponse{State: response.State, Enabled: response.Value}, nil
+}
+
+// locatorStrategy translates a By into the "using"/"value" pair the remote
+// end expects. The W3C spec only recognises "css selector", "link text",
+// "partial link text", "tag name" and "xpath" as locator strategies, so
+// legacy-only strategies such as "class name" or "id" are rewritten to their
+// CSS equivalent when talking to a W3C remote end.
+func locatorStrategy(by By, proto protocol) (string, interface{}) {
+	if proto != protocolW3C {
+		return by.Type(), by.Value()
+	}
+
+	switch by.Type() {
+	case "class name":
+		return "css selector", fmt.Sprintf(".%v", by.Value())
+	case "id":
+		return "css selector", fmt.Sprintf("#%v", by.Value())
+	case "name":
+		return "css selector", fmt.Sprintf("[name=%q]", by.Value())
+	default:
+		return by.Type(), by.Value()
+	}
+}
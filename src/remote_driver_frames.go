@@ -0,0 +1,81 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SwitchToFrameResponse is the response returned from the SwitchToFrame call.
+type SwitchToFrameResponse struct {
+	State string
+}
+
+// SwitchToParentFrameResponse is the response returned from the
+// SwitchToParentFrame call.
+type SwitchToParentFrameResponse struct {
+	State string
+}
+
+func (s *seleniumWebDriver) SwitchToFrame(ctx context.Context, by By) (*SwitchToFrameResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/frame", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("SwitchToFrame()")
+	}
+
+	if by.Type() != "index" {
+		return nil, newInvalidByParameterError("SwitchToFrame()", by.Type())
+	}
+
+	// The JSONWire protocol identifies a frame by its index alone; W3C
+	// identifies it by either an index or an element reference, keyed under
+	// "id" either way.
+	var id interface{} = by.Value()
+	if s.protocol == protocolW3C {
+		if index, ok := by.Value().(uint); ok {
+			id = int(index)
+		}
+	}
+
+	params := map[string]interface{}{
+		"id": id,
+	}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "SwitchToFrame()", params)
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader(marshalledJSON),
+		callingMethod: "SwitchToFrame",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwitchToFrameResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) SwitchToParentFrame(ctx context.Context) (*SwitchToParentFrameResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/frame/parent", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("SwitchToParentFrame()")
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader([]byte("{}")),
+		callingMethod: "SwitchToParentFrame",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwitchToParentFrameResponse{State: resp.State}, nil
+}
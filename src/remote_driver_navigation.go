@@ -2,6 +2,7 @@ package goselenium
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -45,7 +46,7 @@ type TitleResponse struct {
 	Title string
 }
 
-func (s *seleniumWebDriver) Go(goURL string) (*GoResponse, error) {
+func (s *seleniumWebDriver) Go(ctx context.Context, goURL string) (*GoResponse, error) {
 	var err error
 
 	url := fmt.Sprintf("%s/session/%s/url", s.seleniumURL, s.sessionID)
@@ -69,7 +70,7 @@ func (s *seleniumWebDriver) Go(goURL string) (*GoResponse, error) {
 	}
 
 	bodyReader := bytes.NewReader([]byte(marshalledJSON))
-	resp, err := s.stateRequest(&request{
+	resp, err := s.stateRequest(ctx, &request{
 		url:           url,
 		method:        "POST",
 		body:          bodyReader,
@@ -82,7 +83,7 @@ func (s *seleniumWebDriver) Go(goURL string) (*GoResponse, error) {
 	return &GoResponse{State: resp.State}, nil
 }
 
-func (s *seleniumWebDriver) CurrentURL() (*CurrentURLResponse, error) {
+func (s *seleniumWebDriver) CurrentURL(ctx context.Context) (*CurrentURLResponse, error) {
 	var response CurrentURLResponse
 	var err error
 
@@ -92,7 +93,7 @@ func (s *seleniumWebDriver) CurrentURL() (*CurrentURLResponse, error) {
 		return nil, newSessionIDError("CurrentURL()")
 	}
 
-	resp, err := s.valueRequest(&request{
+	resp, err := s.valueRequest(ctx, &request{
 		url:           url,
 		method:        "GET",
 		body:          nil,
@@ -109,7 +110,7 @@ func (s *seleniumWebDriver) CurrentURL() (*CurrentURLResponse, error) {
 	return &response, nil
 }
 
-func (s *seleniumWebDriver) Back() (*BackResponse, error) {
+func (s *seleniumWebDriver) Back(ctx context.Context) (*BackResponse, error) {
 	var err error
 
 	url := fmt.Sprintf("%s/session/%s/back", s.seleniumURL, s.sessionID)
@@ -118,7 +119,7 @@ func (s *seleniumWebDriver) Back() (*BackResponse, error) {
 		return nil, newSessionIDError("Back()")
 	}
 
-	resp, err := s.stateRequest(&request{
+	resp, err := s.stateRequest(ctx, &request{
 		url:           url,
 		method:        "POST",
 		body:          nil,
@@ -131,7 +132,7 @@ func (s *seleniumWebDriver) Back() (*BackResponse, error) {
 	return &BackResponse{State: resp.State}, nil
 }
 
-func (s *seleniumWebDriver) Forward() (*ForwardResponse, error) {
+func (s *seleniumWebDriver) Forward(ctx context.Context) (*ForwardResponse, error) {
 	var err error
 
 	url := fmt.Sprintf("%s/session/%s/forward", s.seleniumURL, s.sessionID)
@@ -140,7 +141,7 @@ func (s *seleniumWebDriver) Forward() (*ForwardResponse, error) {
 		return nil, newSessionIDError("Forward()")
 	}
 
-	resp, err := s.stateRequest(&request{
+	resp, err := s.stateRequest(ctx, &request{
 		url:           url,
 		method:        "POST",
 		body:          nil,
@@ -153,7 +154,7 @@ func (s *seleniumWebDriver) Forward() (*ForwardResponse, error) {
 	return &ForwardResponse{State: resp.State}, nil
 }
 
-func (s *seleniumWebDriver) Refresh() (*RefreshResponse, error) {
+func (s *seleniumWebDriver) Refresh(ctx context.Context) (*RefreshResponse, error) {
 	var err error
 
 	url := fmt.Sprintf("%s/session/%s/refresh", s.seleniumURL, s.sessionID)
@@ -162,7 +163,7 @@ func (s *seleniumWebDriver) Refresh() (*RefreshResponse, error) {
 		return nil, newSessionIDError("Refresh()")
 	}
 
-	resp, err := s.stateRequest(&request{
+	resp, err := s.stateRequest(ctx, &request{
 		url:           url,
 		method:        "POST",
 		body:          nil,
@@ -175,7 +176,7 @@ func (s *seleniumWebDriver) Refresh() (*RefreshResponse, error) {
 	return &RefreshResponse{State: resp.State}, nil
 }
 
-func (s *seleniumWebDriver) Title() (*TitleResponse, error) {
+func (s *seleniumWebDriver) Title(ctx context.Context) (*TitleResponse, error) {
 	var response TitleResponse
 	var err error
 
@@ -185,7 +186,7 @@ func (s *seleniumWebDriver) Title() (*TitleResponse, error) {
 		return nil, newSessionIDError("Title()")
 	}
 
-	resp, err := s.valueRequest(&request{
+	resp, err := s.valueRequest(ctx, &request{
 		url:           url,
 		method:        "GET",
 		body:          nil,
@@ -0,0 +1,60 @@
+package goselenium
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// ScreenshotResponse is the response returned from Screenshot and
+// ElementScreenshot calls. Image holds the decoded PNG bytes.
+type ScreenshotResponse struct {
+	State string
+	Image []byte
+}
+
+func (s *seleniumWebDriver) Screenshot(ctx context.Context) (*ScreenshotResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/screenshot", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("Screenshot()")
+	}
+
+	resp, err := s.valueRequest(ctx, &request{
+		url:           url,
+		method:        "GET",
+		body:          nil,
+		callingMethod: "Screenshot",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(resp.Value)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "Screenshot", resp.Value)
+	}
+
+	return &ScreenshotResponse{State: resp.State, Image: image}, nil
+}
+
+func (e *webElement) Screenshot(ctx context.Context) (*ScreenshotResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/element/%s/screenshot", e.driver.seleniumURL, e.driver.sessionID, e.id)
+
+	resp, err := e.driver.valueRequest(ctx, &request{
+		url:           url,
+		method:        "GET",
+		body:          nil,
+		callingMethod: "Screenshot",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := base64.StdEncoding.DecodeString(resp.Value)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "Screenshot", resp.Value)
+	}
+
+	return &ScreenshotResponse{State: resp.State, Image: image}, nil
+}
@@ -0,0 +1,47 @@
+package goselenium
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+)
+
+func TestScreenshot(t *testing.T) {
+	png := []byte{0x89, 0x50, 0x4e, 0x47}
+
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/screenshot" {
+			t.Errorf("path = %q, want /session/test-session/screenshot", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":"` + base64.StdEncoding.EncodeToString(png) + `"}`))
+	})
+
+	resp, err := driver.Screenshot(context.Background())
+	if err != nil {
+		t.Fatalf("Screenshot() returned error: %v", err)
+	}
+	if string(resp.Image) != string(png) {
+		t.Errorf("Image = %v, want %v", resp.Image, png)
+	}
+}
+
+func TestElementScreenshot(t *testing.T) {
+	png := []byte{0x89, 0x50, 0x4e, 0x47}
+
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/element/elem-1/screenshot" {
+			t.Errorf("path = %q, want /session/test-session/element/elem-1/screenshot", r.URL.Path)
+		}
+		w.Write([]byte(`{"state":"success","value":"` + base64.StdEncoding.EncodeToString(png) + `"}`))
+	})
+	element := &webElement{id: "elem-1", driver: driver}
+
+	resp, err := element.Screenshot(context.Background())
+	if err != nil {
+		t.Fatalf("Screenshot() returned error: %v", err)
+	}
+	if string(resp.Image) != string(png) {
+		t.Errorf("Image = %v, want %v", resp.Image, png)
+	}
+}
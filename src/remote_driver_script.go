@@ -0,0 +1,116 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ExecuteScriptResponse is the response returned from ExecuteScript and
+// ExecuteScriptAsync. Value holds the decoded result; any W3C element
+// reference found within it, at any depth, is replaced with a WebElement
+// bound to the driver that ran the script.
+type ExecuteScriptResponse struct {
+	State string
+	Value interface{}
+}
+
+type scriptRequestBody struct {
+	Script string        `json:"script"`
+	Args   []interface{} `json:"args"`
+}
+
+func (s *seleniumWebDriver) ExecuteScript(ctx context.Context, script string, args []interface{}) (*ExecuteScriptResponse, error) {
+	return s.executeScript(ctx, "/execute/sync", "ExecuteScript", script, args)
+}
+
+func (s *seleniumWebDriver) ExecuteScriptAsync(ctx context.Context, script string, args []interface{}) (*ExecuteScriptResponse, error) {
+	return s.executeScript(ctx, "/execute/async", "ExecuteScriptAsync", script, args)
+}
+
+func (s *seleniumWebDriver) executeScript(ctx context.Context, path, callingMethod, script string, args []interface{}) (*ExecuteScriptResponse, error) {
+	url := fmt.Sprintf("%s/session/%s%s", s.seleniumURL, s.sessionID, path)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError(callingMethod + "()")
+	}
+
+	body := scriptRequestBody{
+		Script: script,
+		Args:   marshalScriptArgs(args),
+	}
+	marshalledJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, newMarshallingError(err, callingMethod+"()", body)
+	}
+
+	resp, err := s.apiService.Do(ctx, "POST", url, bytes.NewReader(marshalledJSON))
+	if err != nil {
+		return nil, newCommunicationError(err, callingMethod, url, resp)
+	}
+
+	var response struct {
+		Value interface{} `json:"value"`
+	}
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, callingMethod, string(resp))
+	}
+
+	return &ExecuteScriptResponse{
+		State: "success",
+		Value: unmarshalScriptValue(response.Value, s),
+	}, nil
+}
+
+// marshalScriptArgs walks args, replacing any WebElement with its W3C
+// element reference so it can be passed through to the script verbatim.
+func marshalScriptArgs(args []interface{}) []interface{} {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = marshalScriptValue(arg)
+	}
+	return converted
+}
+
+func marshalScriptValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case WebElement:
+		return map[string]interface{}{webElementKey: v.ID()}
+	case []interface{}:
+		return marshalScriptArgs(v)
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			converted[key] = marshalScriptValue(val)
+		}
+		return converted
+	default:
+		return value
+	}
+}
+
+// unmarshalScriptValue walks a decoded script result, replacing any W3C
+// element reference with a WebElement bound to driver.
+func unmarshalScriptValue(value interface{}, driver *seleniumWebDriver) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if id, ok := v[webElementKey].(string); ok {
+			return &webElement{id: id, driver: driver}
+		}
+		converted := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			converted[key] = unmarshalScriptValue(val, driver)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, val := range v {
+			converted[i] = unmarshalScriptValue(val, driver)
+		}
+		return converted
+	default:
+		return value
+	}
+}
@@ -0,0 +1,96 @@
+package goselenium
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestExecuteScript(t *testing.T) {
+	var gotBody scriptRequestBody
+
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/execute/sync" {
+			t.Errorf("path = %q, want /session/test-session/execute/sync", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Write([]byte(`{"value":"done"}`))
+	})
+
+	elem := &webElement{id: "elem-1", driver: driver}
+	resp, err := driver.ExecuteScript(context.Background(), "return arguments[0];", []interface{}{elem})
+	if err != nil {
+		t.Fatalf("ExecuteScript() returned error: %v", err)
+	}
+	if resp.Value != "done" {
+		t.Errorf("Value = %v, want %q", resp.Value, "done")
+	}
+
+	if gotBody.Script != "return arguments[0];" {
+		t.Errorf("Script = %q, want %q", gotBody.Script, "return arguments[0];")
+	}
+	ref, ok := gotBody.Args[0].(map[string]interface{})
+	if !ok || ref[webElementKey] != "elem-1" {
+		t.Errorf("Args[0] = %v, want element reference for elem-1", gotBody.Args[0])
+	}
+}
+
+func TestExecuteScriptAsync(t *testing.T) {
+	driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/session/test-session/execute/async" {
+			t.Errorf("path = %q, want /session/test-session/execute/async", r.URL.Path)
+		}
+		w.Write([]byte(`{"value":null}`))
+	})
+
+	if _, err := driver.ExecuteScriptAsync(context.Background(), "arguments[arguments.length-1]();", nil); err != nil {
+		t.Fatalf("ExecuteScriptAsync() returned error: %v", err)
+	}
+}
+
+func TestUnmarshalScriptValueDecodesElementReferences(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	raw := map[string]interface{}{
+		webElementKey: "elem-1",
+	}
+
+	value := unmarshalScriptValue(raw, driver)
+	elem, ok := value.(*webElement)
+	if !ok {
+		t.Fatalf("unmarshalScriptValue() = %T, want *webElement", value)
+	}
+	if elem.ID() != "elem-1" {
+		t.Errorf("ID() = %q, want %q", elem.ID(), "elem-1")
+	}
+}
+
+func TestUnmarshalScriptValueRecursesThroughSlices(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	raw := []interface{}{
+		map[string]interface{}{webElementKey: "elem-1"},
+		"plain string",
+	}
+
+	value := unmarshalScriptValue(raw, driver)
+	slice, ok := value.([]interface{})
+	if !ok || len(slice) != 2 {
+		t.Fatalf("unmarshalScriptValue() = %v, want a 2-element slice", value)
+	}
+	if _, ok := slice[0].(*webElement); !ok {
+		t.Errorf("slice[0] = %T, want *webElement", slice[0])
+	}
+	if slice[1] != "plain string" {
+		t.Errorf("slice[1] = %v, want %q", slice[1], "plain string")
+	}
+}
+
+func TestMarshalScriptValueEncodesWebElements(t *testing.T) {
+	elem := &webElement{id: "elem-1"}
+
+	value := marshalScriptValue(elem)
+	ref, ok := value.(map[string]interface{})
+	if !ok || ref[webElementKey] != "elem-1" {
+		t.Errorf("marshalScriptValue() = %v, want element reference for elem-1", value)
+	}
+}
@@ -0,0 +1,196 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// protocol identifies which wire protocol the remote end is speaking. It is
+// negotiated once, in CreateSession, and used from then on by every request
+// builder whose body or URL shape differs between the two protocols.
+type protocol int
+
+const (
+	// protocolJSONWire is the legacy Selenium 2 / OSS JSONWireProtocol,
+	// spoken by Selenium 2 grids and older standalone servers.
+	protocolJSONWire protocol = iota
+
+	// protocolW3C is the W3C WebDriver protocol, spoken by geckodriver and
+	// chromedriver >=75, and by modern Selenium grids.
+	protocolW3C
+)
+
+// CreateSessionResponse is the response returned from the CreateSession call.
+type CreateSessionResponse struct {
+	State     string
+	SessionID string
+}
+
+// DeleteSessionResponse is the response returned from the DeleteSession call.
+type DeleteSessionResponse struct {
+	State string
+}
+
+// SessionStatusResponse is the response returned from the SessionStatus call.
+type SessionStatusResponse struct {
+	State   string
+	Ready   bool
+	Message string
+}
+
+// SetSessionTimeoutResponse is the response returned from the
+// SetSessionTimeout call.
+type SetSessionTimeoutResponse struct {
+	State string
+}
+
+type newSessionRequestBody struct {
+	DesiredCapabilities map[string]interface{} `json:"desiredCapabilities"`
+	Capabilities        newSessionCapabilities `json:"capabilities"`
+}
+
+type newSessionCapabilities struct {
+	AlwaysMatch map[string]interface{}   `json:"alwaysMatch"`
+	FirstMatch  []map[string]interface{} `json:"firstMatch,omitempty"`
+}
+
+// newSessionResponseBody covers both shapes a remote end can reply with: the
+// W3C response nests sessionId/capabilities under "value", while the legacy
+// JSONWire response puts sessionId at the top level.
+type newSessionResponseBody struct {
+	SessionID string `json:"sessionId"`
+	Value     struct {
+		SessionID    string                 `json:"sessionId"`
+		Capabilities map[string]interface{} `json:"capabilities"`
+		Message      string                 `json:"message"`
+	} `json:"value"`
+}
+
+func (s *seleniumWebDriver) CreateSession(ctx context.Context) (*CreateSessionResponse, error) {
+	url := fmt.Sprintf("%s/session", s.seleniumURL)
+
+	body := newSessionRequestBody{
+		DesiredCapabilities: s.capabilities.jsonWireCapabilities(),
+		Capabilities: newSessionCapabilities{
+			AlwaysMatch: s.capabilities.w3cCapabilities(),
+		},
+	}
+	marshalledJSON, err := json.Marshal(body)
+	if err != nil {
+		return nil, newMarshallingError(err, "CreateSession()", body)
+	}
+
+	resp, err := s.apiService.Do(ctx, "POST", url, bytes.NewReader(marshalledJSON))
+	if err != nil {
+		return nil, newCommunicationError(err, "CreateSession", url, resp)
+	}
+
+	var response newSessionResponseBody
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "CreateSession", string(resp))
+	}
+
+	// A sessionId nested under "value" alongside capabilities means the
+	// remote end replied using the W3C shape; a top-level sessionId with no
+	// such nesting means the legacy JSONWire shape.
+	if response.Value.SessionID != "" && response.Value.Capabilities != nil {
+		s.protocol = protocolW3C
+		s.sessionID = response.Value.SessionID
+	} else {
+		s.protocol = protocolJSONWire
+		s.sessionID = response.SessionID
+	}
+
+	return &CreateSessionResponse{State: "success", SessionID: s.sessionID}, nil
+}
+
+func (s *seleniumWebDriver) DeleteSession(ctx context.Context) (*DeleteSessionResponse, error) {
+	url := fmt.Sprintf("%s/session/%s", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("DeleteSession()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "DeleteSession", url, resp)
+	}
+
+	return &DeleteSessionResponse{State: "success"}, nil
+}
+
+func (s *seleniumWebDriver) SessionStatus(ctx context.Context) (*SessionStatusResponse, error) {
+	url := fmt.Sprintf("%s/status", s.seleniumURL)
+
+	resp, err := s.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "SessionStatus", url, resp)
+	}
+
+	var response struct {
+		Value struct {
+			Ready   bool   `json:"ready"`
+			Message string `json:"message"`
+		} `json:"value"`
+	}
+	err = json.Unmarshal(resp, &response)
+	if err != nil {
+		return nil, newUnmarshallingError(err, "SessionStatus", string(resp))
+	}
+
+	return &SessionStatusResponse{
+		State:   "success",
+		Ready:   response.Value.Ready,
+		Message: response.Value.Message,
+	}, nil
+}
+
+func (s *seleniumWebDriver) SetSessionTimeout(ctx context.Context, to Timeout) (*SetSessionTimeoutResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/timeouts", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("SetSessionTimeout()")
+	}
+
+	// The JSONWire protocol takes the timeout in milliseconds under a "ms"
+	// key alongside its type; W3C always takes milliseconds keyed by timeout
+	// type ("script", "pageLoad", "implicit"). Timeout() already returns
+	// milliseconds, so neither branch needs to convert it.
+	var params map[string]interface{}
+	if s.protocol == protocolW3C {
+		params = map[string]interface{}{
+			w3cTimeoutKey(to.Type()): to.Timeout(),
+		}
+	} else {
+		params = map[string]interface{}{
+			"type": to.Type(),
+			"ms":   to.Timeout(),
+		}
+	}
+
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "SetSessionTimeout()", params)
+	}
+
+	resp, err := s.apiService.Do(ctx, "POST", url, bytes.NewReader(marshalledJSON))
+	if err != nil {
+		return nil, newCommunicationError(err, "SetSessionTimeout", url, resp)
+	}
+
+	return &SetSessionTimeoutResponse{State: "success"}, nil
+}
+
+// w3cTimeoutKey maps a Timeout's Type() (used in the legacy request) to the
+// key the W3C protocol expects.
+func w3cTimeoutKey(timeoutType string) string {
+	switch timeoutType {
+	case "page load":
+		return "pageLoad"
+	default:
+		return timeoutType
+	}
+}
@@ -0,0 +1,120 @@
+package goselenium
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCreateSessionNegotiatesProtocol(t *testing.T) {
+	cases := []struct {
+		name         string
+		responseBody string
+		wantProtocol protocol
+		wantSession  string
+	}{
+		{
+			name:         "w3c shape",
+			responseBody: `{"value":{"sessionId":"w3c-session","capabilities":{"browserName":"chrome"}}}`,
+			wantProtocol: protocolW3C,
+			wantSession:  "w3c-session",
+		},
+		{
+			name:         "jsonwire shape",
+			responseBody: `{"sessionId":"jsonwire-session","status":0,"value":{}}`,
+			wantProtocol: protocolJSONWire,
+			wantSession:  "jsonwire-session",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/session" {
+					t.Errorf("path = %q, want /session", r.URL.Path)
+				}
+				w.Write([]byte(c.responseBody))
+			})
+			driver.sessionID = ""
+			driver.capabilities = &Capabilities{}
+
+			resp, err := driver.CreateSession(context.Background())
+			if err != nil {
+				t.Fatalf("CreateSession() returned error: %v", err)
+			}
+			if resp.SessionID != c.wantSession {
+				t.Errorf("SessionID = %q, want %q", resp.SessionID, c.wantSession)
+			}
+			if driver.protocol != c.wantProtocol {
+				t.Errorf("protocol = %v, want %v", driver.protocol, c.wantProtocol)
+			}
+		})
+	}
+}
+
+func TestLocatorStrategy(t *testing.T) {
+	cssSelector, err := ByCSSSelector("div.foo")
+	if err != nil {
+		t.Fatalf("ByCSSSelector() returned error: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		by        By
+		proto     protocol
+		wantUsing string
+		wantValue interface{}
+	}{
+		{"class name under jsonwire", &by{t: "class name", value: "foo"}, protocolJSONWire, "class name", "foo"},
+		{"id under jsonwire", &by{t: "id", value: "foo"}, protocolJSONWire, "id", "foo"},
+		{"name under jsonwire", &by{t: "name", value: "foo"}, protocolJSONWire, "name", "foo"},
+		{"class name under w3c", &by{t: "class name", value: "foo"}, protocolW3C, "css selector", ".foo"},
+		{"id under w3c", &by{t: "id", value: "foo"}, protocolW3C, "css selector", "#foo"},
+		{"name under w3c", &by{t: "name", value: "foo"}, protocolW3C, "css selector", `[name="foo"]`},
+		{"css selector under w3c is passed through", cssSelector, protocolW3C, "css selector", "div.foo"},
+		{"css selector under jsonwire is passed through", cssSelector, protocolJSONWire, "css selector", "div.foo"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			using, value := locatorStrategy(c.by, c.proto)
+			if using != c.wantUsing {
+				t.Errorf("using = %q, want %q", using, c.wantUsing)
+			}
+			if value != c.wantValue {
+				t.Errorf("value = %v, want %v", value, c.wantValue)
+			}
+		})
+	}
+}
+
+func TestSetSessionTimeoutProtocolBranching(t *testing.T) {
+	cases := []struct {
+		name     string
+		proto    protocol
+		wantBody string
+	}{
+		{"jsonwire uses type/ms", protocolJSONWire, `{"ms":5000,"type":"implicit"}`},
+		{"w3c uses the timeout-keyed shape", protocolW3C, `{"implicit":5000}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var gotBody string
+			driver := newTestDriver(t, func(w http.ResponseWriter, r *http.Request) {
+				buf := make([]byte, r.ContentLength)
+				r.Body.Read(buf)
+				gotBody = string(buf)
+				w.Write([]byte(`{"state":"success","value":null}`))
+			})
+			driver.protocol = c.proto
+
+			if _, err := driver.SetSessionTimeout(context.Background(), SessionImplicitWaitTimeout(5000)); err != nil {
+				t.Fatalf("SetSessionTimeout() returned error: %v", err)
+			}
+			if gotBody != c.wantBody {
+				t.Errorf("request body = %s, want %s", gotBody, c.wantBody)
+			}
+		})
+	}
+}
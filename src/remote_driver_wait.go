@@ -0,0 +1,93 @@
+package goselenium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WaitOption configures the behaviour of Wait.
+type WaitOption func(*waitConfig)
+
+// WithPollInterval sets how often the condition is re-evaluated while
+// waiting. The default is 500 milliseconds.
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		c.pollInterval = interval
+	}
+}
+
+// WithTimeout sets the overall deadline for Wait, measured from the first
+// call to Evaluate. The default is 30 seconds.
+func WithTimeout(timeout time.Duration) WaitOption {
+	return func(c *waitConfig) {
+		c.timeout = timeout
+	}
+}
+
+// WithIgnoredErrors configures Wait to swallow the supplied errors while
+// polling rather than returning them immediately, retrying instead until the
+// condition succeeds or the wait expires. This is useful for transient
+// errors, such as ErrNoSuchElement while the page is still settling. Errors
+// are matched with errors.Is, so passing a sentinel such as ErrNoSuchElement
+// matches it regardless of which call produced the wrapping error.
+func WithIgnoredErrors(errs ...error) WaitOption {
+	return func(c *waitConfig) {
+		c.ignoredErrors = append(c.ignoredErrors, errs...)
+	}
+}
+
+type waitConfig struct {
+	pollInterval  time.Duration
+	timeout       time.Duration
+	ignoredErrors []error
+}
+
+func defaultWaitConfig() *waitConfig {
+	return &waitConfig{
+		pollInterval: 500 * time.Millisecond,
+		timeout:      30 * time.Second,
+	}
+}
+
+func (c *waitConfig) ignores(err error) bool {
+	for _, ignored := range c.ignoredErrors {
+		if errors.Is(err, ignored) {
+			return true
+		}
+	}
+	return false
+}
+
+// Wait polls cond at the configured interval until it reports success, an
+// unignored error is returned, or the context/timeout (whichever happens
+// first) expires.
+func (s *seleniumWebDriver) Wait(ctx context.Context, cond Condition, opts ...WaitOption) error {
+	cfg := defaultWaitConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(cfg.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := cond.Evaluate(ctx, s)
+		if err != nil && !cfg.ignores(err) {
+			return err
+		}
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("goselenium: Wait() timed out: %v", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
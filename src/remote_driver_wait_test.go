@@ -0,0 +1,195 @@
+package goselenium
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeCondition is a Condition whose Evaluate behaviour is driven entirely by
+// the test, with no need for a real WebDriver.
+type fakeCondition struct {
+	evaluate func(calls int) (bool, error)
+	calls    int32
+}
+
+func (c *fakeCondition) Evaluate(ctx context.Context, driver WebDriver) (bool, error) {
+	n := int(atomic.AddInt32(&c.calls, 1))
+	return c.evaluate(n)
+}
+
+func TestWaitRespectsPollInterval(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) {
+		return n >= 3, nil
+	}}
+
+	start := time.Now()
+	err := driver.Wait(context.Background(), cond, WithPollInterval(20*time.Millisecond), WithTimeout(time.Second))
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("Wait() returned error: %v", err)
+	}
+	if atomic.LoadInt32(&cond.calls) != 3 {
+		t.Errorf("Evaluate called %d times, want 3", cond.calls)
+	}
+	if elapsed < 40*time.Millisecond {
+		t.Errorf("Wait() returned after %v, want at least 2 poll intervals (40ms)", elapsed)
+	}
+}
+
+func TestWaitTimesOut(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) {
+		return false, nil
+	}}
+
+	err := driver.Wait(context.Background(), cond, WithPollInterval(5*time.Millisecond), WithTimeout(30*time.Millisecond))
+	if err == nil {
+		t.Fatal("Wait() returned nil error, want a timeout error")
+	}
+}
+
+func TestWaitStopsOnParentContextCancellation(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) {
+		return false, nil
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	err := driver.Wait(ctx, cond, WithPollInterval(5*time.Millisecond), WithTimeout(time.Hour))
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("Wait() returned nil error, want an error from the cancelled context")
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("Wait() took %v to notice context cancellation, want well under the 1h timeout", elapsed)
+	}
+}
+
+func TestWaitReturnsUnignoredErrorImmediately(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	wantErr := errors.New("boom")
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) {
+		return false, wantErr
+	}}
+
+	err := driver.Wait(context.Background(), cond, WithTimeout(time.Second))
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Wait() error = %v, want %v", err, wantErr)
+	}
+	if atomic.LoadInt32(&cond.calls) != 1 {
+		t.Errorf("Evaluate called %d times, want 1 (an unignored error must stop Wait immediately)", cond.calls)
+	}
+}
+
+func TestWaitIgnoresConfiguredErrors(t *testing.T) {
+	driver := &seleniumWebDriver{}
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) {
+		if n < 3 {
+			return false, ErrNoSuchElement
+		}
+		return true, nil
+	}}
+
+	err := driver.Wait(context.Background(), cond, WithPollInterval(5*time.Millisecond), WithIgnoredErrors(ErrNoSuchElement))
+	if err != nil {
+		t.Fatalf("Wait() returned error: %v", err)
+	}
+	if atomic.LoadInt32(&cond.calls) != 3 {
+		t.Errorf("Evaluate called %d times, want 3", cond.calls)
+	}
+}
+
+func TestAnyShortCircuitsOnFirstSuccess(t *testing.T) {
+	var secondCalled bool
+	first := &fakeCondition{evaluate: func(n int) (bool, error) { return true, nil }}
+	second := &fakeCondition{evaluate: func(n int) (bool, error) { secondCalled = true; return true, nil }}
+
+	ok, err := Any(first, second).Evaluate(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Any() returned error: %v", err)
+	}
+	if !ok {
+		t.Error("Any() = false, want true")
+	}
+	if secondCalled {
+		t.Error("Any() evaluated the second condition after the first already succeeded")
+	}
+}
+
+func TestAnyFailsWhenAllConditionsFail(t *testing.T) {
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) { return false, nil }}
+
+	ok, err := Any(cond, cond).Evaluate(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Any() returned error: %v", err)
+	}
+	if ok {
+		t.Error("Any() = true, want false")
+	}
+}
+
+func TestAllShortCircuitsOnFirstFailure(t *testing.T) {
+	var secondCalled bool
+	first := &fakeCondition{evaluate: func(n int) (bool, error) { return false, nil }}
+	second := &fakeCondition{evaluate: func(n int) (bool, error) { secondCalled = true; return true, nil }}
+
+	ok, err := All(first, second).Evaluate(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("All() returned error: %v", err)
+	}
+	if ok {
+		t.Error("All() = true, want false")
+	}
+	if secondCalled {
+		t.Error("All() evaluated the second condition after the first already failed")
+	}
+}
+
+func TestAllSucceedsWhenEveryConditionSucceeds(t *testing.T) {
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) { return true, nil }}
+
+	ok, err := All(cond, cond).Evaluate(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("All() returned error: %v", err)
+	}
+	if !ok {
+		t.Error("All() = false, want true")
+	}
+}
+
+func TestNotInvertsResult(t *testing.T) {
+	cond := &fakeCondition{evaluate: func(n int) (bool, error) { return true, nil }}
+
+	ok, err := Not(cond).Evaluate(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Not() returned error: %v", err)
+	}
+	if ok {
+		t.Error("Not() = true, want false")
+	}
+}
+
+// driverWithoutAlertSupport embeds WebDriver so it satisfies the interface
+// without implementing hasAlert, exercising AlertPresent's fallback path for
+// drivers that aren't an alertChecker.
+type driverWithoutAlertSupport struct {
+	WebDriver
+}
+
+func TestAlertPresentRequiresAlertChecker(t *testing.T) {
+	if _, err := AlertPresent().Evaluate(context.Background(), &driverWithoutAlertSupport{}); err == nil {
+		t.Error("AlertPresent() against a driver with no alert support = nil error, want InvalidArgumentError")
+	}
+}
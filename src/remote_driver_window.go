@@ -0,0 +1,154 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// WindowHandleResponse is the response returned from the WindowHandle call.
+type WindowHandleResponse struct {
+	State  string
+	Handle string
+}
+
+// CloseWindowResponse is the response returned from the CloseWindow call.
+type CloseWindowResponse struct {
+	State   string
+	Handles []string
+}
+
+// SwitchToWindowResponse is the response returned from the SwitchToWindow
+// call.
+type SwitchToWindowResponse struct {
+	State string
+}
+
+// WindowHandlesResponse is the response returned from the WindowHandles call.
+type WindowHandlesResponse struct {
+	State   string
+	Handles []string
+}
+
+// WindowSizeResponse is the response returned from the WindowSize call.
+type WindowSizeResponse struct {
+	State  string
+	Width  int
+	Height int
+}
+
+func (s *seleniumWebDriver) WindowHandle(ctx context.Context) (*WindowHandleResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/window", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("WindowHandle()")
+	}
+
+	resp, err := s.valueRequest(ctx, &request{
+		url:           url,
+		method:        "GET",
+		body:          nil,
+		callingMethod: "WindowHandle",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &WindowHandleResponse{State: resp.State, Handle: resp.Value}, nil
+}
+
+func (s *seleniumWebDriver) CloseWindow(ctx context.Context) (*CloseWindowResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/window", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("CloseWindow()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "CloseWindow", url, resp)
+	}
+
+	var response struct {
+		Value []string `json:"value"`
+	}
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return nil, newUnmarshallingError(err, "CloseWindow", string(resp))
+	}
+
+	return &CloseWindowResponse{State: "success", Handles: response.Value}, nil
+}
+
+func (s *seleniumWebDriver) SwitchToWindow(ctx context.Context, handle string) (*SwitchToWindowResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/window", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("SwitchToWindow()")
+	}
+
+	params := map[string]interface{}{"handle": handle}
+	marshalledJSON, err := json.Marshal(params)
+	if err != nil {
+		return nil, newMarshallingError(err, "SwitchToWindow()", params)
+	}
+
+	resp, err := s.stateRequest(ctx, &request{
+		url:           url,
+		method:        "POST",
+		body:          bytes.NewReader(marshalledJSON),
+		callingMethod: "SwitchToWindow",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SwitchToWindowResponse{State: resp.State}, nil
+}
+
+func (s *seleniumWebDriver) WindowHandles(ctx context.Context) (*WindowHandlesResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/window/handles", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("WindowHandles()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "WindowHandles", url, resp)
+	}
+
+	var response struct {
+		Value []string `json:"value"`
+	}
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return nil, newUnmarshallingError(err, "WindowHandles", string(resp))
+	}
+
+	return &WindowHandlesResponse{State: "success", Handles: response.Value}, nil
+}
+
+func (s *seleniumWebDriver) WindowSize(ctx context.Context) (*WindowSizeResponse, error) {
+	url := fmt.Sprintf("%s/session/%s/window/rect", s.seleniumURL, s.sessionID)
+
+	if s.sessionID == "" {
+		return nil, newSessionIDError("WindowSize()")
+	}
+
+	resp, err := s.apiService.Do(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, newCommunicationError(err, "WindowSize", url, resp)
+	}
+
+	var response struct {
+		Value struct {
+			Width  int `json:"width"`
+			Height int `json:"height"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(resp, &response); err != nil {
+		return nil, newUnmarshallingError(err, "WindowSize", string(resp))
+	}
+
+	return &WindowSizeResponse{State: "success", Width: response.Value.Width, Height: response.Value.Height}, nil
+}
@@ -0,0 +1,205 @@
+package goselenium
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Service manages a local WebDriver-compatible process (chromedriver,
+// geckodriver, or selenium-server.jar), so that callers can write
+// self-contained tests without depending on a pre-running Selenium server.
+type Service struct {
+	cmd  *exec.Cmd
+	addr string
+}
+
+// ServiceOption configures a Service before it is started.
+type ServiceOption func(*serviceConfig)
+
+type serviceConfig struct {
+	output       *os.File
+	javaPath     string
+	display      string
+	xauthority   string
+	startTimeout time.Duration
+}
+
+func defaultServiceConfig() *serviceConfig {
+	return &serviceConfig{
+		javaPath:     "java",
+		startTimeout: 20 * time.Second,
+	}
+}
+
+// Output directs the managed process's stdout and stderr to f.
+func Output(f *os.File) ServiceOption {
+	return func(c *serviceConfig) {
+		c.output = f
+	}
+}
+
+// JavaPath overrides the java binary used to launch selenium-server.jar. It
+// has no effect on NewChromeDriverService or NewGeckoDriverService.
+func JavaPath(path string) ServiceOption {
+	return func(c *serviceConfig) {
+		c.javaPath = path
+	}
+}
+
+// Display runs the managed process against an existing X display, setting
+// DISPLAY and XAUTHORITY in its environment. See also FrameBuffer, which
+// spawns a display for you.
+func Display(display, xauthority string) ServiceOption {
+	return func(c *serviceConfig) {
+		c.display = display
+		c.xauthority = xauthority
+	}
+}
+
+// StartTimeout bounds how long to wait for the managed process's /status
+// endpoint to report ready. The default is 20 seconds.
+func StartTimeout(timeout time.Duration) ServiceOption {
+	return func(c *serviceConfig) {
+		c.startTimeout = timeout
+	}
+}
+
+// NewChromeDriverService starts a local chromedriver binary listening on
+// port.
+func NewChromeDriverService(path string, port int, opts ...ServiceOption) (*Service, error) {
+	cmd := exec.Command(path, fmt.Sprintf("--port=%d", port))
+	return startService(cmd, port, opts)
+}
+
+// NewGeckoDriverService starts a local geckodriver binary listening on port.
+func NewGeckoDriverService(path string, port int, opts ...ServiceOption) (*Service, error) {
+	cmd := exec.Command(path, "--port", strconv.Itoa(port))
+	return startService(cmd, port, opts)
+}
+
+// NewSeleniumService starts a local selenium-server.jar listening on port.
+func NewSeleniumService(jarPath string, port int, opts ...ServiceOption) (*Service, error) {
+	cfg := defaultServiceConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	cmd := exec.Command(cfg.javaPath, "-jar", jarPath, "-port", strconv.Itoa(port))
+	return startServiceCmd(cmd, port, cfg)
+}
+
+func startService(cmd *exec.Cmd, port int, opts []ServiceOption) (*Service, error) {
+	cfg := defaultServiceConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return startServiceCmd(cmd, port, cfg)
+}
+
+func startServiceCmd(cmd *exec.Cmd, port int, cfg *serviceConfig) (*Service, error) {
+	if cfg.output != nil {
+		cmd.Stdout = cfg.output
+		cmd.Stderr = cfg.output
+	}
+	if cfg.display != "" {
+		cmd.Env = append(os.Environ(), "DISPLAY="+cfg.display, "XAUTHORITY="+cfg.xauthority)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, newServiceStartError(err, cmd.Path)
+	}
+
+	service := &Service{
+		cmd:  cmd,
+		addr: fmt.Sprintf("http://127.0.0.1:%d", port),
+	}
+
+	if err := service.waitUntilReady(cfg.startTimeout); err != nil {
+		service.Stop()
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// waitUntilReady polls /status (via SessionStatus, same as any other
+// WebDriver) until the managed process reports it is ready to create
+// sessions, or timeout elapses.
+func (s *Service) waitUntilReady(timeout time.Duration) error {
+	driver := &seleniumWebDriver{
+		seleniumURL: s.addr,
+		apiService:  defaultDriverConfig().buildTransport(),
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		status, err := driver.SessionStatus(context.Background())
+		if err == nil && status.Ready {
+			return nil
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	return newServiceStartError(fmt.Errorf("timed out waiting for %s/status to report ready", s.addr), s.addr)
+}
+
+// URL returns the base URL of the managed process, suitable for passing to
+// NewSeleniumWebDriver.
+func (s *Service) URL() string {
+	return s.addr
+}
+
+// Stop terminates the managed process.
+func (s *Service) Stop() error {
+	if s.cmd.Process == nil {
+		return nil
+	}
+	return s.cmd.Process.Kill()
+}
+
+// FrameBuffer manages an Xvfb process, giving a managed Service a virtual X
+// display to run against in headless environments.
+type FrameBuffer struct {
+	cmd     *exec.Cmd
+	Display string
+}
+
+// NewFrameBuffer starts Xvfb on the first free display number, returning a
+// FrameBuffer whose Display field can be passed to the Display() option.
+func NewFrameBuffer() (*FrameBuffer, error) {
+	display, err := freeDisplay()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command("Xvfb", display, "-screen", "0", "1280x1024x24")
+	if err := cmd.Start(); err != nil {
+		return nil, newServiceStartError(err, "Xvfb")
+	}
+
+	return &FrameBuffer{cmd: cmd, Display: display}, nil
+}
+
+// Stop terminates the Xvfb process.
+func (f *FrameBuffer) Stop() error {
+	if f.cmd.Process == nil {
+		return nil
+	}
+	return f.cmd.Process.Kill()
+}
+
+func freeDisplay() (string, error) {
+	for n := 99; n < 200; n++ {
+		lockFile := fmt.Sprintf("/tmp/.X%d-lock", n)
+		if _, err := os.Stat(lockFile); os.IsNotExist(err) {
+			return fmt.Sprintf(":%d", n), nil
+		}
+	}
+	return "", errors.New("No free X display found")
+}
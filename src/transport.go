@@ -0,0 +1,273 @@
+package goselenium
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Transport performs the underlying HTTP request/response cycle for a
+// WebDriver. NewSeleniumWebDriver builds the default implementation from the
+// DriverOptions passed to it; supply your own via WithTransport to route
+// through a proxy, add tracing, or talk to a remote end this package has no
+// native support for.
+type Transport interface {
+	// Do performs a single request against url using method, sending body
+	// (which may be nil), and returns the raw response body. If the remote
+	// end responded but with a non-2xx status, Do returns that response body
+	// alongside the error rather than discarding it, so callers such as
+	// newCommunicationError can inspect the W3C error code it carries.
+	Do(ctx context.Context, method, url string, body io.Reader) ([]byte, error)
+}
+
+// apiService is the historical internal name for Transport.
+type apiService = Transport
+
+// RetryPolicy controls how the default Transport retries failed requests.
+// Idempotent requests (GET, HEAD, DELETE) are retried on 5xx responses and
+// network errors; POSTs are only retried when the connection was reset
+// before the server could have acted on them.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first. A
+	// value <= 1 disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry; later retries back off
+	// exponentially from it.
+	BaseDelay time.Duration
+}
+
+// RequestLogger is called by the default Transport after every attempt it
+// makes, retries included, with that attempt's duration and error.
+type RequestLogger func(method, url string, elapsed time.Duration, err error)
+
+// DriverOption configures a WebDriver created by NewSeleniumWebDriver.
+type DriverOption func(*driverConfig)
+
+type driverConfig struct {
+	transport    Transport
+	httpClient   *http.Client
+	username     string
+	password     string
+	hasBasicAuth bool
+	headers      http.Header
+	retry        RetryPolicy
+	logger       RequestLogger
+	tlsConfig    *tls.Config
+}
+
+func defaultDriverConfig() *driverConfig {
+	return &driverConfig{
+		httpClient: &http.Client{},
+		headers:    http.Header{},
+		retry:      RetryPolicy{MaxAttempts: 1},
+	}
+}
+
+// WithHTTPClient overrides the *http.Client used by the default Transport.
+func WithHTTPClient(client *http.Client) DriverOption {
+	return func(c *driverConfig) {
+		c.httpClient = client
+	}
+}
+
+// WithBasicAuth sends HTTP basic auth credentials with every request, as
+// required by grids such as Selenoid, Sauce Labs or BrowserStack when
+// accessed behind an auth proxy.
+func WithBasicAuth(username, password string) DriverOption {
+	return func(c *driverConfig) {
+		c.username = username
+		c.password = password
+		c.hasBasicAuth = true
+	}
+}
+
+// WithHeader adds a static header to every request made by the default
+// Transport.
+func WithHeader(key, value string) DriverOption {
+	return func(c *driverConfig) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithRetry configures the default Transport's retry behaviour. See
+// RetryPolicy for the rules governing what gets retried.
+func WithRetry(policy RetryPolicy) DriverOption {
+	return func(c *driverConfig) {
+		c.retry = policy
+	}
+}
+
+// WithRequestLogger registers a callback invoked after every request made by
+// the default Transport.
+func WithRequestLogger(logger RequestLogger) DriverOption {
+	return func(c *driverConfig) {
+		c.logger = logger
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the default Transport's
+// HTTP client. It has no effect when that client already has its own
+// Transport set, e.g. one supplied through WithHTTPClient.
+func WithTLSConfig(tlsConfig *tls.Config) DriverOption {
+	return func(c *driverConfig) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// WithTransport replaces the default Transport entirely. When set, the
+// other With* options that configure the default implementation (
+// WithHTTPClient, WithBasicAuth, WithHeader, WithRetry, WithRequestLogger,
+// WithTLSConfig) are ignored.
+func WithTransport(transport Transport) DriverOption {
+	return func(c *driverConfig) {
+		c.transport = transport
+	}
+}
+
+func (c *driverConfig) buildTransport() Transport {
+	if c.transport != nil {
+		return c.transport
+	}
+
+	if c.tlsConfig != nil && c.httpClient.Transport == nil {
+		c.httpClient.Transport = &http.Transport{TLSClientConfig: c.tlsConfig}
+	}
+
+	return &defaultTransport{
+		client:       c.httpClient,
+		username:     c.username,
+		password:     c.password,
+		hasBasicAuth: c.hasBasicAuth,
+		headers:      c.headers,
+		retry:        c.retry,
+		logger:       c.logger,
+	}
+}
+
+// defaultTransport is the Transport used unless WithTransport overrides it.
+type defaultTransport struct {
+	client       *http.Client
+	username     string
+	password     string
+	hasBasicAuth bool
+	headers      http.Header
+	retry        RetryPolicy
+	logger       RequestLogger
+}
+
+func (t *defaultTransport) Do(ctx context.Context, method, url string, body io.Reader) ([]byte, error) {
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	maxAttempts := t.retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var lastErr error
+	var lastBody []byte
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := t.retry.BaseDelay * time.Duration(int64(1)<<uint(attempt-1))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		start := time.Now()
+		respBody, status, err := t.attempt(ctx, method, url, bodyBytes)
+		if t.logger != nil {
+			t.logger(method, url, time.Since(start), err)
+		}
+		if err == nil {
+			return respBody, nil
+		}
+		lastErr = err
+		lastBody = respBody
+
+		if !isRetryable(method, status, err) {
+			return lastBody, err
+		}
+	}
+
+	return lastBody, lastErr
+}
+
+func (t *defaultTransport) attempt(ctx context.Context, method, url string, body []byte) ([]byte, int, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, values := range t.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if t.hasBasicAuth {
+		req.SetBasicAuth(t.username, t.password)
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return respBody, resp.StatusCode, fmt.Errorf("goselenium: %s %s returned status %d", method, url, resp.StatusCode)
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// isRetryable reports whether a failed request should be retried. GETs,
+// HEADs and DELETEs are idempotent and retried on a 5xx response or network
+// error; POSTs are only retried when the connection was reset, since the
+// server may otherwise have already acted on the request.
+func isRetryable(method string, status int, err error) bool {
+	if method == http.MethodGet || method == http.MethodHead || method == http.MethodDelete {
+		return status >= 500 || isNetworkError(err)
+	}
+	if method == http.MethodPost {
+		return isConnectionReset(err)
+	}
+	return false
+}
+
+func isNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+func isConnectionReset(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "connection reset")
+}
@@ -0,0 +1,144 @@
+package goselenium
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDefaultTransportRetriesGetOn500(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("boom"))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	transport := (&driverConfig{
+		httpClient: &http.Client{},
+		headers:    http.Header{},
+		retry:      RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}).buildTransport()
+
+	body, err := transport.Do(context.Background(), http.MethodGet, ts.URL, nil)
+	if err != nil {
+		t.Fatalf("Do() returned error: %v", err)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestDefaultTransportDoesNotRetryPostOnPlainTimeout(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("too late"))
+	}))
+	defer ts.Close()
+
+	transport := (&driverConfig{
+		httpClient: &http.Client{},
+		headers:    http.Header{},
+		retry:      RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+	}).buildTransport()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	_, err := transport.Do(ctx, http.MethodPost, ts.URL, nil)
+	if err == nil {
+		t.Fatal("Do() returned nil error, want a timeout error")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("attempts = %d, want 1 (a plain timeout must not be retried for POST)", got)
+	}
+}
+
+func TestDefaultTransportRetriesPostOnConnectionReset(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			hj, ok := w.(http.Hijacker)
+			if !ok {
+				t.Fatal("ResponseWriter does not support hijacking")
+			}
+			conn, _, err := hj.Hijack()
+			if err != nil {
+				t.Fatalf("Hijack() returned error: %v", err)
+			}
+			if tcp, ok := conn.(*net.TCPConn); ok {
+				tcp.SetLinger(0)
+			}
+			conn.Close()
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	transport := (&driverConfig{
+		httpClient: &http.Client{},
+		headers:    http.Header{},
+		retry:      RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond},
+	}).buildTransport()
+
+	body, err := transport.Do(context.Background(), http.MethodPost, ts.URL, nil)
+	if err != nil {
+		t.Fatalf("Do() returned error: %v", err)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("attempts = %d, want 2 (a connection reset must be retried for POST)", got)
+	}
+}
+
+func TestIsConnectionReset(t *testing.T) {
+	if isConnectionReset(nil) {
+		t.Error("isConnectionReset(nil) = true, want false")
+	}
+	if !isConnectionReset(&net.OpError{Op: "read", Err: errConnReset{}}) {
+		t.Error("isConnectionReset() = false for a connection reset error, want true")
+	}
+}
+
+type errConnReset struct{}
+
+func (errConnReset) Error() string { return "connection reset by peer" }
+
+func TestIsRetryable(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{"get 5xx", http.MethodGet, 503, nil, true},
+		{"get 4xx", http.MethodGet, 404, nil, false},
+		{"post 5xx", http.MethodPost, 503, nil, false},
+		{"post connection reset", http.MethodPost, 0, &net.OpError{Op: "read", Err: errConnReset{}}, true},
+		{"post plain error", http.MethodPost, 0, context.DeadlineExceeded, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isRetryable(c.method, c.status, c.err); got != c.want {
+				t.Errorf("isRetryable(%q, %d, %v) = %v, want %v", c.method, c.status, c.err, got, c.want)
+			}
+		})
+	}
+}
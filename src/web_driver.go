@@ -1,7 +1,11 @@
 package goselenium
 
+import "context"
+
 // WebDriver is an interface which adheres to the W3C specification
 // for WebDrivers (https://w3c.github.io/webdriver/webdriver-spec.html).
+// Every method that issues a remote command takes a context.Context so
+// callers can bound or cancel long-running commands.
 type WebDriver interface {
 	/*
 		PROPERTY ACCESS METHODS
@@ -16,74 +20,199 @@ type WebDriver interface {
 
 	// CreateSession creates a session in the remote driver with the
 	// desired capabilities.
-	CreateSession() (*CreateSessionResponse, error)
+	CreateSession(ctx context.Context) (*CreateSessionResponse, error)
 
 	// DeleteSession deletes the current session associated with the web driver.
-	DeleteSession() (*DeleteSessionResponse, error)
+	DeleteSession(ctx context.Context) (*DeleteSessionResponse, error)
 
 	// SessionStatus gets the status about whether a remove end is in a state
 	// which it can create new sessions.
-	SessionStatus() (*SessionStatusResponse, error)
+	SessionStatus(ctx context.Context) (*SessionStatusResponse, error)
 
 	// SetSessionTimeout sets a timeout for one of the 3 options.
 	// Call SessionScriptTimeout() to generate a script timeout.
 	// Call SessionPageLoadTimeout() to generate a page load timeout.
 	// Call SessionImplicitWaitTimeout() to generate an implicit wait timeout.
-	SetSessionTimeout(to Timeout) (*SetSessionTimeoutResponse, error)
+	SetSessionTimeout(ctx context.Context, to Timeout) (*SetSessionTimeoutResponse, error)
 
 	/*
 		NAVIGATION METHODS
 	*/
 
 	// Go forces the browser to perform a GET request on a URL.
-	Go(url string) (*GoResponse, error)
+	Go(ctx context.Context, url string) (*GoResponse, error)
 
 	// CurrentURL returns the current URL of the top level browsing context.
-	CurrentURL() (*CurrentURLResponse, error)
+	CurrentURL(ctx context.Context) (*CurrentURLResponse, error)
 
 	// Back instructs the web driver to go one step back in the page history.
-	Back() (*BackResponse, error)
+	Back(ctx context.Context) (*BackResponse, error)
 
 	// Forward instructs the web driver to go one step forward in the page history.
-	Forward() (*ForwardResponse, error)
+	Forward(ctx context.Context) (*ForwardResponse, error)
 
 	// Refresh instructs the web driver to refresh the page that it is currently on.
-	Refresh() (*RefreshResponse, error)
+	Refresh(ctx context.Context) (*RefreshResponse, error)
 
 	// Title gets the title of the current page of the web driver.
-	Title() (*TitleResponse, error)
+	Title(ctx context.Context) (*TitleResponse, error)
 
 	/*
 		COMMAND METHODS
 	*/
 
 	// WindowHandle retrieves the current active browsing string for the current session.
-	WindowHandle() (*WindowHandleResponse, error)
+	WindowHandle(ctx context.Context) (*WindowHandleResponse, error)
 
 	// CloseWindow closes the current active window (see WindowHandle() for what
 	// window that will be).
-	CloseWindow() (*CloseWindowResponse, error)
+	CloseWindow(ctx context.Context) (*CloseWindowResponse, error)
 
 	// SwitchToWindow switches the current browsing context to a specified window
 	// handle.
-	SwitchToWindow(handle string) (*SwitchToWindowResponse, error)
+	SwitchToWindow(ctx context.Context, handle string) (*SwitchToWindowResponse, error)
 
 	// WindowHandles gets all of the window handles for the current session.
 	// To retrieve the currently active window handle, see WindowHandle().
-	WindowHandles() (*WindowHandlesResponse, error)
+	WindowHandles(ctx context.Context) (*WindowHandlesResponse, error)
 
 	// SwitchToFrame switches to a frame determined by the "by" parameter.
 	// You can use ByIndex to find the frame to switch to. Any other
 	// By implementation will yield an InvalidByParameter error.
-	SwitchToFrame(by By) (*SwitchToFrameResponse, error)
+	SwitchToFrame(ctx context.Context, by By) (*SwitchToFrameResponse, error)
 
 	// SwitchToParentFrame switches to the parent of the current top level
 	// browsing context.
-	SwitchToParentFrame() (*SwitchToParentFrameResponse, error)
+	SwitchToParentFrame(ctx context.Context) (*SwitchToParentFrameResponse, error)
 
 	// WindowSize retrieves the current browser window size for the
 	// active session.
-	WindowSize() (*WindowSizeResponse, error)
+	WindowSize(ctx context.Context) (*WindowSizeResponse, error)
+
+	/*
+		ELEMENT METHODS
+	*/
+
+	// FindElement locates the first element matching "by" within the
+	// current browsing context.
+	FindElement(ctx context.Context, by By) (WebElement, error)
+
+	// FindElements locates every element matching "by" within the
+	// current browsing context.
+	FindElements(ctx context.Context, by By) ([]WebElement, error)
+
+	/*
+		SYNCHRONISATION METHODS
+	*/
+
+	// Wait polls cond at a configurable interval until it is satisfied, an
+	// unignored error is returned by the condition, or opts' timeout (30
+	// seconds by default) or ctx is exceeded/cancelled first. It replaces
+	// ad-hoc time.Sleep polling loops around calls such as CurrentURL().
+	Wait(ctx context.Context, cond Condition, opts ...WaitOption) error
+
+	/*
+		COOKIE METHODS
+	*/
+
+	// AddCookie adds a cookie to the current browsing context.
+	AddCookie(ctx context.Context, cookie Cookie) (*AddCookieResponse, error)
+
+	// GetCookie retrieves the cookie with the given name from the current
+	// browsing context.
+	GetCookie(ctx context.Context, name string) (*GetCookieResponse, error)
+
+	// GetCookies retrieves every cookie visible to the current browsing
+	// context.
+	GetCookies(ctx context.Context) (*GetCookiesResponse, error)
+
+	// DeleteCookie deletes the cookie with the given name.
+	DeleteCookie(ctx context.Context, name string) (*DeleteCookieResponse, error)
+
+	// DeleteAllCookies deletes every cookie visible to the current browsing
+	// context.
+	DeleteAllCookies(ctx context.Context) (*DeleteAllCookiesResponse, error)
+
+	/*
+		ALERT METHODS
+	*/
+
+	// AcceptAlert accepts the currently open alert, confirm, or prompt
+	// dialog.
+	AcceptAlert(ctx context.Context) (*AcceptAlertResponse, error)
+
+	// DismissAlert dismisses the currently open alert, confirm, or prompt
+	// dialog.
+	DismissAlert(ctx context.Context) (*DismissAlertResponse, error)
+
+	// AlertText retrieves the message text of the currently open dialog.
+	AlertText(ctx context.Context) (*AlertTextResponse, error)
+
+	// SendAlertText types text into the currently open prompt dialog.
+	SendAlertText(ctx context.Context, text string) (*SendAlertTextResponse, error)
+
+	/*
+		SCREENSHOT METHODS
+	*/
+
+	// Screenshot captures a PNG screenshot of the current page.
+	Screenshot(ctx context.Context) (*ScreenshotResponse, error)
+
+	/*
+		SCRIPT EXECUTION METHODS
+	*/
+
+	// ExecuteScript runs script synchronously in the context of the current
+	// page, passing args as its arguments. Any WebElement in args is
+	// marshalled as a W3C element reference; WebElement references in the
+	// result are decoded back into WebElement values.
+	ExecuteScript(ctx context.Context, script string, args []interface{}) (*ExecuteScriptResponse, error)
+
+	// ExecuteScriptAsync runs script asynchronously: the script receives an
+	// additional final argument, a callback function, and the command does
+	// not return until that callback is invoked.
+	ExecuteScriptAsync(ctx context.Context, script string, args []interface{}) (*ExecuteScriptResponse, error)
+
+	/*
+		ACTIONS METHODS
+	*/
+
+	// PerformActions dispatches the pointer/key input sequences built by an
+	// ActionsBuilder.
+	PerformActions(ctx context.Context, actions *ActionsBuilder) (*PerformActionsResponse, error)
+
+	// ReleaseActions releases all keys and pointer buttons currently
+	// depressed, as tracked by the remote end's input state.
+	ReleaseActions(ctx context.Context) (*ReleaseActionsResponse, error)
+}
+
+// WebElement represents a single element found on the page. It wraps the
+// W3C element reference returned by the remote end by commands such as
+// FindElement.
+type WebElement interface {
+	// ID returns the remote end's element reference for this element.
+	ID() string
+
+	// Click sends a W3C element click command to the element.
+	Click(ctx context.Context) (*ElementClickResponse, error)
+
+	// Displayed reports whether the element would be visible to a user.
+	Displayed(ctx context.Context) (*ElementDisplayedResponse, error)
+
+	// Enabled reports whether the element is enabled.
+	Enabled(ctx context.Context) (*ElementEnabledResponse, error)
+
+	// Screenshot captures a PNG screenshot of just this element.
+	Screenshot(ctx context.Context) (*ScreenshotResponse, error)
+}
+
+// Condition represents a single check that Wait polls for. It is evaluated
+// against the WebDriver it was passed to until it returns true, an error is
+// returned that is not in the ignored list, or the wait expires.
+type Condition interface {
+	// Evaluate runs the condition against the given WebDriver and reports
+	// whether it has been satisfied.
+	Evaluate(ctx context.Context, driver WebDriver) (bool, error)
 }
 
 // Timeout is an interface which specifies what all timeout requests must follow.